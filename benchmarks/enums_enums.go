@@ -0,0 +1,6164 @@
+// Code generated by goenum. DO NOT EDIT.
+
+package benchmarks
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"github.com/donutnomad/goenum/enums"
+	"iter"
+	"strconv"
+	"strings"
+)
+
+// =================================================================================================
+// Priority
+// =================================================================================================
+
+// Priority is a type that represents a single enum value.
+// It combines the core information about the enum constant and its defined fields.
+type Priority struct {
+	priority
+}
+
+// Verify that Priority implements the Enum interface
+var _ enums.Enum[int, Priority] = Priority{}
+
+// priorityContainer is the container for all enum values.
+// It is private and should not be used directly use the public methods on the Priority type.
+type priorityContainer struct {
+	Low    Priority
+	Medium Priority
+	High   Priority
+}
+
+// Priorities is a main entry point using the Priority type.
+// It is a container for all enum values and provides a convenient way to access all enum values and perform
+// operations, with convenience methods for common use cases.
+var Priorities = priorityContainer{
+	Low:    Priority{low},
+	Medium: Priority{medium},
+	High:   Priority{high},
+}
+
+// priorityNamesMap maps enum values to their names array
+var priorityNamesMap = map[Priority][]string{
+	Priorities.Low: {
+		"low",
+	},
+	Priorities.Medium: {
+		"medium",
+	},
+	Priorities.High: {
+		"high",
+	},
+}
+
+// priorityNameIndex maps every name to its enum value
+var priorityNameIndex = map[string]Priority{
+	"low":    Priorities.Low,
+	"medium": Priorities.Medium,
+	"high":   Priorities.High,
+}
+
+// PriorityRaw is a type alias for the underlying enum type priority.
+// It provides direct access to the raw enum values for cases where you need
+// to work with the underlying type directly.
+type PriorityRaw = priority
+
+// allSlice returns a slice of all enum values.
+func (t priorityContainer) allSlice() []Priority {
+	return []Priority{
+		Priorities.Low,
+		Priorities.Medium,
+		Priorities.High,
+	}
+}
+
+// Val implements the Enum interface.
+func (t Priority) Val() int {
+	return int(t.priority)
+}
+
+// All implements the Enum interface.
+func (t Priority) All() iter.Seq[Priority] {
+	return func(yield func(Priority) bool) {
+		for _, v := range Priorities.allSlice() {
+			if !v.IsValid() {
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// IsValid implements the Enum interface.
+func (t Priority) IsValid() bool {
+	return true
+}
+
+// Name implements the Enum interface.
+// Returns the first name of the enum value.
+func (t Priority) Name() string {
+	if names, ok := priorityNamesMap[t]; ok && len(names) > 0 {
+		return names[0]
+	}
+	return ""
+}
+
+// NameWith returns the name at the specified index.
+// If the index is out of bounds, returns the last name.
+func (t Priority) NameWith(idx int) string {
+	names, ok := priorityNamesMap[t]
+	if !ok || len(names) == 0 {
+		return ""
+	}
+	if idx < 0 || idx >= len(names) {
+		return names[len(names)-1]
+	}
+	return names[idx]
+}
+
+// Names returns all names of the enum value.
+func (t Priority) Names() []string {
+	if names, ok := priorityNamesMap[t]; ok {
+		return names
+	}
+	return []string{}
+}
+
+// String implements the Stringer interface.
+func (t Priority) String() string {
+	if names, ok := priorityNamesMap[t]; ok && len(names) > 0 {
+		return names[0]
+	}
+	return fmt.Sprintf("priority(%v)", t.priority)
+}
+
+// SerdeFormat implements the Enum interface.
+func (t Priority) SerdeFormat() enums.Format {
+	return enums.FormatValue
+}
+
+// FromName implements the Enum interface.
+func (t Priority) FromName(name string) (Priority, bool) {
+	if v, ok := priorityNameIndex[name]; ok {
+		return v, v.IsValid()
+	}
+	var zero Priority
+	return zero, false
+}
+
+// FromValue implements the Enum interface.
+// Invalid values are never returned.
+func (t Priority) FromValue(value int) (Priority, bool) {
+	switch priority(value) {
+	case low:
+		return Priorities.Low, true
+	case medium:
+		return Priorities.Medium, true
+	case high:
+		return Priorities.High, true
+	}
+	var zero Priority
+	return zero, false
+}
+
+// All container methods for convenience
+func (t priorityContainer) All() iter.Seq[Priority] {
+	return Priority{}.All()
+}
+
+func (t priorityContainer) FromName(name string) (Priority, bool) {
+	return Priority{}.FromName(name)
+}
+
+func (t priorityContainer) FromValue(value int) (Priority, bool) {
+	return Priority{}.FromValue(value)
+}
+
+// Scan implements the database/sql.Scanner interface for Priority.
+func (t *Priority) Scan(value any) error {
+	if v, ok := value.(int64); ok && int64(int(v)) == v {
+		if result, ok := t.FromValue(int(v)); ok {
+			*t = result
+			return nil
+		}
+	}
+	result, err := enums.SQLScan(*t, value)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+
+// Value implements the database/sql/driver.Valuer interface for Priority.
+func (t Priority) Value() (driver.Value, error) {
+	return int64(t.priority), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for Priority.
+func (t Priority) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(t.priority), 10), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Priority.
+func (t *Priority) UnmarshalJSON(data []byte) error {
+	// Known values in canonical form are decoded without enums.UnmarshalJSON
+	if v, err := strconv.ParseInt(string(data), 10, 64); err == nil {
+		var buf [20]byte
+		if string(strconv.AppendInt(buf[:0], v, 10)) == string(data) {
+			if result, ok := t.FromValue(int(v)); ok {
+				*t = result
+				return nil
+			}
+		}
+	}
+	result, err := enums.UnmarshalJSON(*t, data)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for Priority.
+func (t Priority) MarshalText() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(t.priority), 10), nil
+}
+
+// AppendText implements the encoding.TextAppender interface for Priority.
+func (t Priority) AppendText(b []byte) ([]byte, error) {
+	return enums.AppendText(t, b)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for Priority.
+func (t *Priority) UnmarshalText(data []byte) error {
+	if v, err := strconv.ParseInt(string(data), 10, 64); err == nil {
+		if result, ok := t.FromValue(int(v)); ok {
+			*t = result
+			return nil
+		}
+	}
+	result, err := enums.UnmarshalText(*t, data)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for Priority.
+func (t Priority) MarshalBinary() ([]byte, error) {
+	return enums.AppendBinary(t, nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for Priority.
+func (t Priority) AppendBinary(b []byte) ([]byte, error) {
+	return enums.AppendBinary(t, b)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Priority.
+func (t *Priority) UnmarshalBinary(data []byte) error {
+	result, err := enums.UnmarshalBinary(*t, data)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface for Priority.
+func (t Priority) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(strconv.FormatInt(int64(t.priority), 10), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for Priority.
+func (t *Priority) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return &enums.InvalidValueError{Type: "Priority", Format: t.SerdeFormat(), Err: err}
+	}
+	return t.UnmarshalXMLAttr(xml.Attr{Name: start.Name, Value: strings.TrimSpace(text)})
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for Priority.
+func (t Priority) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: strconv.FormatInt(int64(t.priority), 10)}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for Priority.
+func (t *Priority) UnmarshalXMLAttr(attr xml.Attr) error {
+	if v, err := strconv.ParseInt(attr.Value, 10, 64); err == nil {
+		if result, ok := t.FromValue(int(v)); ok {
+			*t = result
+			return nil
+		}
+	}
+	result, err := enums.UnmarshalXMLAttr(*t, attr)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface for Priority.
+func (t Priority) MarshalMsgpack() ([]byte, error) {
+	return enums.AppendMsgpack(t, nil)
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface for Priority.
+func (t *Priority) UnmarshalMsgpack(data []byte) error {
+	result, err := enums.UnmarshalMsgpack(*t, data)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface for Priority.
+func (t Priority) MarshalCBOR() ([]byte, error) {
+	return enums.AppendCBOR(t, nil)
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface for Priority.
+func (t *Priority) UnmarshalCBOR(data []byte) error {
+	result, err := enums.UnmarshalCBOR(*t, data)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+
+// =================================================================================================
+// Channel
+// =================================================================================================
+
+// Channel is a type that represents a single enum value.
+// It combines the core information about the enum constant and its defined fields.
+type Channel struct {
+	channel
+}
+
+// Verify that Channel implements the Enum interface
+var _ enums.Enum[string, Channel] = Channel{}
+
+// channelContainer is the container for all enum values.
+// It is private and should not be used directly use the public methods on the Channel type.
+type channelContainer struct {
+	Email Channel
+	Sms   Channel
+	Push  Channel
+}
+
+// Channels is a main entry point using the Channel type.
+// It is a container for all enum values and provides a convenient way to access all enum values and perform
+// operations, with convenience methods for common use cases.
+var Channels = channelContainer{
+	Email: Channel{email},
+	Sms:   Channel{sms},
+	Push:  Channel{push},
+}
+
+// channelNamesMap maps enum values to their names array
+var channelNamesMap = map[Channel][]string{
+	Channels.Email: {
+		"email",
+	},
+	Channels.Sms: {
+		"sms",
+	},
+	Channels.Push: {
+		"push",
+	},
+}
+
+// channelNameIndex maps every name to its enum value
+var channelNameIndex = map[string]Channel{
+	"email": Channels.Email,
+	"sms":   Channels.Sms,
+	"push":  Channels.Push,
+}
+
+// ChannelRaw is a type alias for the underlying enum type channel.
+// It provides direct access to the raw enum values for cases where you need
+// to work with the underlying type directly.
+type ChannelRaw = channel
+
+// allSlice returns a slice of all enum values.
+func (t channelContainer) allSlice() []Channel {
+	return []Channel{
+		Channels.Email,
+		Channels.Sms,
+		Channels.Push,
+	}
+}
+
+// Val implements the Enum interface.
+func (t Channel) Val() string {
+	return string(t.channel)
+}
+
+// All implements the Enum interface.
+func (t Channel) All() iter.Seq[Channel] {
+	return func(yield func(Channel) bool) {
+		for _, v := range Channels.allSlice() {
+			if !v.IsValid() {
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// IsValid implements the Enum interface.
+func (t Channel) IsValid() bool {
+	return true
+}
+
+// Name implements the Enum interface.
+// Returns the first name of the enum value.
+func (t Channel) Name() string {
+	if names, ok := channelNamesMap[t]; ok && len(names) > 0 {
+		return names[0]
+	}
+	return ""
+}
+
+// NameWith returns the name at the specified index.
+// If the index is out of bounds, returns the last name.
+func (t Channel) NameWith(idx int) string {
+	names, ok := channelNamesMap[t]
+	if !ok || len(names) == 0 {
+		return ""
+	}
+	if idx < 0 || idx >= len(names) {
+		return names[len(names)-1]
+	}
+	return names[idx]
+}
+
+// Names returns all names of the enum value.
+func (t Channel) Names() []string {
+	if names, ok := channelNamesMap[t]; ok {
+		return names
+	}
+	return []string{}
+}
+
+// String implements the Stringer interface.
+func (t Channel) String() string {
+	if names, ok := channelNamesMap[t]; ok && len(names) > 0 {
+		return names[0]
+	}
+	return fmt.Sprintf("channel(%v)", t.channel)
+}
+
+// SerdeFormat implements the Enum interface.
+func (t Channel) SerdeFormat() enums.Format {
+	return enums.FormatValue
+}
+
+// FromName implements the Enum interface.
+func (t Channel) FromName(name string) (Channel, bool) {
+	if v, ok := channelNameIndex[name]; ok {
+		return v, v.IsValid()
+	}
+	var zero Channel
+	return zero, false
+}
+
+// FromValue implements the Enum interface.
+// Invalid values are never returned.
+func (t Channel) FromValue(value string) (Channel, bool) {
+	switch channel(value) {
+	case email:
+		return Channels.Email, true
+	case sms:
+		return Channels.Sms, true
+	case push:
+		return Channels.Push, true
+	}
+	var zero Channel
+	return zero, false
+}
+
+// All container methods for convenience
+func (t channelContainer) All() iter.Seq[Channel] {
+	return Channel{}.All()
+}
+
+func (t channelContainer) FromName(name string) (Channel, bool) {
+	return Channel{}.FromName(name)
+}
+
+func (t channelContainer) FromValue(value string) (Channel, bool) {
+	return Channel{}.FromValue(value)
+}
+
+// Scan implements the database/sql.Scanner interface for Channel.
+func (t *Channel) Scan(value any) error {
+	switch v := value.(type) {
+	case string:
+		if result, ok := t.FromValue(v); ok {
+			*t = result
+			return nil
+		}
+	case []byte:
+		if result, ok := t.FromValue(string(v)); ok {
+			*t = result
+			return nil
+		}
+	}
+	result, err := enums.SQLScan(*t, value)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+
+// Value implements the database/sql/driver.Valuer interface for Channel.
+func (t Channel) Value() (driver.Value, error) {
+	return string(t.channel), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for Channel.
+func (t Channel) MarshalJSON() ([]byte, error) {
+	// Values without characters that JSON escapes are quoted without enums.MarshalJSON
+	s := string(t.channel)
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < ' ' || c > '~' || c == '"' || c == '\\' || c == '<' || c == '>' || c == '&' {
+			return enums.MarshalJSON(t, t.channel)
+		}
+	}
+	return append(append(append(make([]byte, 0, len(s)+2), '"'), s...), '"'), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Channel.
+func (t *Channel) UnmarshalJSON(data []byte) error {
+	// Known values without escape sequences are decoded without enums.UnmarshalJSON
+	if n := len(data); n >= 2 && data[0] == '"' && data[n-1] == '"' {
+		if v, err := strconv.Unquote(string(data)); err == nil && len(v) == n-2 {
+			if result, ok := t.FromValue(string(v)); ok {
+				*t = result
+				return nil
+			}
+		}
+	}
+	result, err := enums.UnmarshalJSON(*t, data)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for Channel.
+func (t Channel) MarshalText() ([]byte, error) {
+	return []byte(t.channel), nil
+}
+
+// AppendText implements the encoding.TextAppender interface for Channel.
+func (t Channel) AppendText(b []byte) ([]byte, error) {
+	return enums.AppendText(t, b)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for Channel.
+func (t *Channel) UnmarshalText(data []byte) error {
+	if result, ok := t.FromValue(string(data)); ok {
+		*t = result
+		return nil
+	}
+	result, err := enums.UnmarshalText(*t, data)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for Channel.
+func (t Channel) MarshalBinary() ([]byte, error) {
+	return enums.AppendBinary(t, nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for Channel.
+func (t Channel) AppendBinary(b []byte) ([]byte, error) {
+	return enums.AppendBinary(t, b)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Channel.
+func (t *Channel) UnmarshalBinary(data []byte) error {
+	result, err := enums.UnmarshalBinary(*t, data)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface for Channel.
+func (t Channel) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(string(t.channel), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for Channel.
+func (t *Channel) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return &enums.InvalidValueError{Type: "Channel", Format: t.SerdeFormat(), Err: err}
+	}
+	return t.UnmarshalXMLAttr(xml.Attr{Name: start.Name, Value: strings.TrimSpace(text)})
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for Channel.
+func (t Channel) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: string(t.channel)}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for Channel.
+func (t *Channel) UnmarshalXMLAttr(attr xml.Attr) error {
+	if result, ok := t.FromValue(attr.Value); ok {
+		*t = result
+		return nil
+	}
+	result, err := enums.UnmarshalXMLAttr(*t, attr)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface for Channel.
+func (t Channel) MarshalMsgpack() ([]byte, error) {
+	return enums.AppendMsgpack(t, nil)
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface for Channel.
+func (t *Channel) UnmarshalMsgpack(data []byte) error {
+	result, err := enums.UnmarshalMsgpack(*t, data)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface for Channel.
+func (t Channel) MarshalCBOR() ([]byte, error) {
+	return enums.AppendCBOR(t, nil)
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface for Channel.
+func (t *Channel) UnmarshalCBOR(data []byte) error {
+	result, err := enums.UnmarshalCBOR(*t, data)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+
+// =================================================================================================
+// Size5
+// =================================================================================================
+
+// Size5 is a type that represents a single enum value.
+// It combines the core information about the enum constant and its defined fields.
+type Size5 struct {
+	size5
+}
+
+// Verify that Size5 implements the Enum interface
+var _ enums.Enum[int, Size5] = Size5{}
+
+// size5Container is the container for all enum values.
+// It is private and should not be used directly use the public methods on the Size5 type.
+type size5Container struct {
+	A0 Size5
+	A1 Size5
+	A2 Size5
+	A3 Size5
+	A4 Size5
+}
+
+// Size5s is a main entry point using the Size5 type.
+// It is a container for all enum values and provides a convenient way to access all enum values and perform
+// operations, with convenience methods for common use cases.
+var Size5s = size5Container{
+	A0: Size5{a0},
+	A1: Size5{a1},
+	A2: Size5{a2},
+	A3: Size5{a3},
+	A4: Size5{a4},
+}
+
+// size5NamesMap maps enum values to their names array
+var size5NamesMap = map[Size5][]string{
+	Size5s.A0: {
+		"a0",
+	},
+	Size5s.A1: {
+		"a1",
+	},
+	Size5s.A2: {
+		"a2",
+	},
+	Size5s.A3: {
+		"a3",
+	},
+	Size5s.A4: {
+		"a4",
+	},
+}
+
+// size5NameIndex maps every name to its enum value
+var size5NameIndex = map[string]Size5{
+	"a0": Size5s.A0,
+	"a1": Size5s.A1,
+	"a2": Size5s.A2,
+	"a3": Size5s.A3,
+	"a4": Size5s.A4,
+}
+
+// Size5Raw is a type alias for the underlying enum type size5.
+// It provides direct access to the raw enum values for cases where you need
+// to work with the underlying type directly.
+type Size5Raw = size5
+
+// allSlice returns a slice of all enum values.
+func (t size5Container) allSlice() []Size5 {
+	return []Size5{
+		Size5s.A0,
+		Size5s.A1,
+		Size5s.A2,
+		Size5s.A3,
+		Size5s.A4,
+	}
+}
+
+// Val implements the Enum interface.
+func (t Size5) Val() int {
+	return int(t.size5)
+}
+
+// All implements the Enum interface.
+func (t Size5) All() iter.Seq[Size5] {
+	return func(yield func(Size5) bool) {
+		for _, v := range Size5s.allSlice() {
+			if !v.IsValid() {
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// IsValid implements the Enum interface.
+func (t Size5) IsValid() bool {
+	return true
+}
+
+// Name implements the Enum interface.
+// Returns the first name of the enum value.
+func (t Size5) Name() string {
+	if names, ok := size5NamesMap[t]; ok && len(names) > 0 {
+		return names[0]
+	}
+	return ""
+}
+
+// NameWith returns the name at the specified index.
+// If the index is out of bounds, returns the last name.
+func (t Size5) NameWith(idx int) string {
+	names, ok := size5NamesMap[t]
+	if !ok || len(names) == 0 {
+		return ""
+	}
+	if idx < 0 || idx >= len(names) {
+		return names[len(names)-1]
+	}
+	return names[idx]
+}
+
+// Names returns all names of the enum value.
+func (t Size5) Names() []string {
+	if names, ok := size5NamesMap[t]; ok {
+		return names
+	}
+	return []string{}
+}
+
+// String implements the Stringer interface.
+func (t Size5) String() string {
+	if names, ok := size5NamesMap[t]; ok && len(names) > 0 {
+		return names[0]
+	}
+	return fmt.Sprintf("size5(%v)", t.size5)
+}
+
+// SerdeFormat implements the Enum interface.
+func (t Size5) SerdeFormat() enums.Format {
+	return enums.FormatValue
+}
+
+// FromName implements the Enum interface.
+func (t Size5) FromName(name string) (Size5, bool) {
+	if v, ok := size5NameIndex[name]; ok {
+		return v, v.IsValid()
+	}
+	var zero Size5
+	return zero, false
+}
+
+// FromValue implements the Enum interface.
+// Invalid values are never returned.
+func (t Size5) FromValue(value int) (Size5, bool) {
+	switch size5(value) {
+	case a0:
+		return Size5s.A0, true
+	case a1:
+		return Size5s.A1, true
+	case a2:
+		return Size5s.A2, true
+	case a3:
+		return Size5s.A3, true
+	case a4:
+		return Size5s.A4, true
+	}
+	var zero Size5
+	return zero, false
+}
+
+// All container methods for convenience
+func (t size5Container) All() iter.Seq[Size5] {
+	return Size5{}.All()
+}
+
+func (t size5Container) FromName(name string) (Size5, bool) {
+	return Size5{}.FromName(name)
+}
+
+func (t size5Container) FromValue(value int) (Size5, bool) {
+	return Size5{}.FromValue(value)
+}
+
+// MarshalJSON implements the json.Marshaler interface for Size5.
+func (t Size5) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(t.size5), 10), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Size5.
+func (t *Size5) UnmarshalJSON(data []byte) error {
+	// Known values in canonical form are decoded without enums.UnmarshalJSON
+	if v, err := strconv.ParseInt(string(data), 10, 64); err == nil {
+		var buf [20]byte
+		if string(strconv.AppendInt(buf[:0], v, 10)) == string(data) {
+			if result, ok := t.FromValue(int(v)); ok {
+				*t = result
+				return nil
+			}
+		}
+	}
+	result, err := enums.UnmarshalJSON(*t, data)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+
+// =================================================================================================
+// Size50
+// =================================================================================================
+
+// Size50 is a type that represents a single enum value.
+// It combines the core information about the enum constant and its defined fields.
+type Size50 struct {
+	size50
+}
+
+// Verify that Size50 implements the Enum interface
+var _ enums.Enum[int, Size50] = Size50{}
+
+// size50Container is the container for all enum values.
+// It is private and should not be used directly use the public methods on the Size50 type.
+type size50Container struct {
+	B0  Size50
+	B1  Size50
+	B2  Size50
+	B3  Size50
+	B4  Size50
+	B5  Size50
+	B6  Size50
+	B7  Size50
+	B8  Size50
+	B9  Size50
+	B10 Size50
+	B11 Size50
+	B12 Size50
+	B13 Size50
+	B14 Size50
+	B15 Size50
+	B16 Size50
+	B17 Size50
+	B18 Size50
+	B19 Size50
+	B20 Size50
+	B21 Size50
+	B22 Size50
+	B23 Size50
+	B24 Size50
+	B25 Size50
+	B26 Size50
+	B27 Size50
+	B28 Size50
+	B29 Size50
+	B30 Size50
+	B31 Size50
+	B32 Size50
+	B33 Size50
+	B34 Size50
+	B35 Size50
+	B36 Size50
+	B37 Size50
+	B38 Size50
+	B39 Size50
+	B40 Size50
+	B41 Size50
+	B42 Size50
+	B43 Size50
+	B44 Size50
+	B45 Size50
+	B46 Size50
+	B47 Size50
+	B48 Size50
+	B49 Size50
+}
+
+// Size50s is a main entry point using the Size50 type.
+// It is a container for all enum values and provides a convenient way to access all enum values and perform
+// operations, with convenience methods for common use cases.
+var Size50s = size50Container{
+	B0:  Size50{b0},
+	B1:  Size50{b1},
+	B2:  Size50{b2},
+	B3:  Size50{b3},
+	B4:  Size50{b4},
+	B5:  Size50{b5},
+	B6:  Size50{b6},
+	B7:  Size50{b7},
+	B8:  Size50{b8},
+	B9:  Size50{b9},
+	B10: Size50{b10},
+	B11: Size50{b11},
+	B12: Size50{b12},
+	B13: Size50{b13},
+	B14: Size50{b14},
+	B15: Size50{b15},
+	B16: Size50{b16},
+	B17: Size50{b17},
+	B18: Size50{b18},
+	B19: Size50{b19},
+	B20: Size50{b20},
+	B21: Size50{b21},
+	B22: Size50{b22},
+	B23: Size50{b23},
+	B24: Size50{b24},
+	B25: Size50{b25},
+	B26: Size50{b26},
+	B27: Size50{b27},
+	B28: Size50{b28},
+	B29: Size50{b29},
+	B30: Size50{b30},
+	B31: Size50{b31},
+	B32: Size50{b32},
+	B33: Size50{b33},
+	B34: Size50{b34},
+	B35: Size50{b35},
+	B36: Size50{b36},
+	B37: Size50{b37},
+	B38: Size50{b38},
+	B39: Size50{b39},
+	B40: Size50{b40},
+	B41: Size50{b41},
+	B42: Size50{b42},
+	B43: Size50{b43},
+	B44: Size50{b44},
+	B45: Size50{b45},
+	B46: Size50{b46},
+	B47: Size50{b47},
+	B48: Size50{b48},
+	B49: Size50{b49},
+}
+
+// size50NamesMap maps enum values to their names array
+var size50NamesMap = map[Size50][]string{
+	Size50s.B0: {
+		"b0",
+	},
+	Size50s.B1: {
+		"b1",
+	},
+	Size50s.B2: {
+		"b2",
+	},
+	Size50s.B3: {
+		"b3",
+	},
+	Size50s.B4: {
+		"b4",
+	},
+	Size50s.B5: {
+		"b5",
+	},
+	Size50s.B6: {
+		"b6",
+	},
+	Size50s.B7: {
+		"b7",
+	},
+	Size50s.B8: {
+		"b8",
+	},
+	Size50s.B9: {
+		"b9",
+	},
+	Size50s.B10: {
+		"b10",
+	},
+	Size50s.B11: {
+		"b11",
+	},
+	Size50s.B12: {
+		"b12",
+	},
+	Size50s.B13: {
+		"b13",
+	},
+	Size50s.B14: {
+		"b14",
+	},
+	Size50s.B15: {
+		"b15",
+	},
+	Size50s.B16: {
+		"b16",
+	},
+	Size50s.B17: {
+		"b17",
+	},
+	Size50s.B18: {
+		"b18",
+	},
+	Size50s.B19: {
+		"b19",
+	},
+	Size50s.B20: {
+		"b20",
+	},
+	Size50s.B21: {
+		"b21",
+	},
+	Size50s.B22: {
+		"b22",
+	},
+	Size50s.B23: {
+		"b23",
+	},
+	Size50s.B24: {
+		"b24",
+	},
+	Size50s.B25: {
+		"b25",
+	},
+	Size50s.B26: {
+		"b26",
+	},
+	Size50s.B27: {
+		"b27",
+	},
+	Size50s.B28: {
+		"b28",
+	},
+	Size50s.B29: {
+		"b29",
+	},
+	Size50s.B30: {
+		"b30",
+	},
+	Size50s.B31: {
+		"b31",
+	},
+	Size50s.B32: {
+		"b32",
+	},
+	Size50s.B33: {
+		"b33",
+	},
+	Size50s.B34: {
+		"b34",
+	},
+	Size50s.B35: {
+		"b35",
+	},
+	Size50s.B36: {
+		"b36",
+	},
+	Size50s.B37: {
+		"b37",
+	},
+	Size50s.B38: {
+		"b38",
+	},
+	Size50s.B39: {
+		"b39",
+	},
+	Size50s.B40: {
+		"b40",
+	},
+	Size50s.B41: {
+		"b41",
+	},
+	Size50s.B42: {
+		"b42",
+	},
+	Size50s.B43: {
+		"b43",
+	},
+	Size50s.B44: {
+		"b44",
+	},
+	Size50s.B45: {
+		"b45",
+	},
+	Size50s.B46: {
+		"b46",
+	},
+	Size50s.B47: {
+		"b47",
+	},
+	Size50s.B48: {
+		"b48",
+	},
+	Size50s.B49: {
+		"b49",
+	},
+}
+
+// size50NameIndex maps every name to its enum value
+var size50NameIndex = map[string]Size50{
+	"b0":  Size50s.B0,
+	"b1":  Size50s.B1,
+	"b2":  Size50s.B2,
+	"b3":  Size50s.B3,
+	"b4":  Size50s.B4,
+	"b5":  Size50s.B5,
+	"b6":  Size50s.B6,
+	"b7":  Size50s.B7,
+	"b8":  Size50s.B8,
+	"b9":  Size50s.B9,
+	"b10": Size50s.B10,
+	"b11": Size50s.B11,
+	"b12": Size50s.B12,
+	"b13": Size50s.B13,
+	"b14": Size50s.B14,
+	"b15": Size50s.B15,
+	"b16": Size50s.B16,
+	"b17": Size50s.B17,
+	"b18": Size50s.B18,
+	"b19": Size50s.B19,
+	"b20": Size50s.B20,
+	"b21": Size50s.B21,
+	"b22": Size50s.B22,
+	"b23": Size50s.B23,
+	"b24": Size50s.B24,
+	"b25": Size50s.B25,
+	"b26": Size50s.B26,
+	"b27": Size50s.B27,
+	"b28": Size50s.B28,
+	"b29": Size50s.B29,
+	"b30": Size50s.B30,
+	"b31": Size50s.B31,
+	"b32": Size50s.B32,
+	"b33": Size50s.B33,
+	"b34": Size50s.B34,
+	"b35": Size50s.B35,
+	"b36": Size50s.B36,
+	"b37": Size50s.B37,
+	"b38": Size50s.B38,
+	"b39": Size50s.B39,
+	"b40": Size50s.B40,
+	"b41": Size50s.B41,
+	"b42": Size50s.B42,
+	"b43": Size50s.B43,
+	"b44": Size50s.B44,
+	"b45": Size50s.B45,
+	"b46": Size50s.B46,
+	"b47": Size50s.B47,
+	"b48": Size50s.B48,
+	"b49": Size50s.B49,
+}
+
+// Size50Raw is a type alias for the underlying enum type size50.
+// It provides direct access to the raw enum values for cases where you need
+// to work with the underlying type directly.
+type Size50Raw = size50
+
+// allSlice returns a slice of all enum values.
+func (t size50Container) allSlice() []Size50 {
+	return []Size50{
+		Size50s.B0,
+		Size50s.B1,
+		Size50s.B2,
+		Size50s.B3,
+		Size50s.B4,
+		Size50s.B5,
+		Size50s.B6,
+		Size50s.B7,
+		Size50s.B8,
+		Size50s.B9,
+		Size50s.B10,
+		Size50s.B11,
+		Size50s.B12,
+		Size50s.B13,
+		Size50s.B14,
+		Size50s.B15,
+		Size50s.B16,
+		Size50s.B17,
+		Size50s.B18,
+		Size50s.B19,
+		Size50s.B20,
+		Size50s.B21,
+		Size50s.B22,
+		Size50s.B23,
+		Size50s.B24,
+		Size50s.B25,
+		Size50s.B26,
+		Size50s.B27,
+		Size50s.B28,
+		Size50s.B29,
+		Size50s.B30,
+		Size50s.B31,
+		Size50s.B32,
+		Size50s.B33,
+		Size50s.B34,
+		Size50s.B35,
+		Size50s.B36,
+		Size50s.B37,
+		Size50s.B38,
+		Size50s.B39,
+		Size50s.B40,
+		Size50s.B41,
+		Size50s.B42,
+		Size50s.B43,
+		Size50s.B44,
+		Size50s.B45,
+		Size50s.B46,
+		Size50s.B47,
+		Size50s.B48,
+		Size50s.B49,
+	}
+}
+
+// Val implements the Enum interface.
+func (t Size50) Val() int {
+	return int(t.size50)
+}
+
+// All implements the Enum interface.
+func (t Size50) All() iter.Seq[Size50] {
+	return func(yield func(Size50) bool) {
+		for _, v := range Size50s.allSlice() {
+			if !v.IsValid() {
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// IsValid implements the Enum interface.
+func (t Size50) IsValid() bool {
+	return true
+}
+
+// Name implements the Enum interface.
+// Returns the first name of the enum value.
+func (t Size50) Name() string {
+	if names, ok := size50NamesMap[t]; ok && len(names) > 0 {
+		return names[0]
+	}
+	return ""
+}
+
+// NameWith returns the name at the specified index.
+// If the index is out of bounds, returns the last name.
+func (t Size50) NameWith(idx int) string {
+	names, ok := size50NamesMap[t]
+	if !ok || len(names) == 0 {
+		return ""
+	}
+	if idx < 0 || idx >= len(names) {
+		return names[len(names)-1]
+	}
+	return names[idx]
+}
+
+// Names returns all names of the enum value.
+func (t Size50) Names() []string {
+	if names, ok := size50NamesMap[t]; ok {
+		return names
+	}
+	return []string{}
+}
+
+// String implements the Stringer interface.
+func (t Size50) String() string {
+	if names, ok := size50NamesMap[t]; ok && len(names) > 0 {
+		return names[0]
+	}
+	return fmt.Sprintf("size50(%v)", t.size50)
+}
+
+// SerdeFormat implements the Enum interface.
+func (t Size50) SerdeFormat() enums.Format {
+	return enums.FormatValue
+}
+
+// FromName implements the Enum interface.
+func (t Size50) FromName(name string) (Size50, bool) {
+	if v, ok := size50NameIndex[name]; ok {
+		return v, v.IsValid()
+	}
+	var zero Size50
+	return zero, false
+}
+
+// FromValue implements the Enum interface.
+// Invalid values are never returned.
+func (t Size50) FromValue(value int) (Size50, bool) {
+	switch size50(value) {
+	case b0:
+		return Size50s.B0, true
+	case b1:
+		return Size50s.B1, true
+	case b2:
+		return Size50s.B2, true
+	case b3:
+		return Size50s.B3, true
+	case b4:
+		return Size50s.B4, true
+	case b5:
+		return Size50s.B5, true
+	case b6:
+		return Size50s.B6, true
+	case b7:
+		return Size50s.B7, true
+	case b8:
+		return Size50s.B8, true
+	case b9:
+		return Size50s.B9, true
+	case b10:
+		return Size50s.B10, true
+	case b11:
+		return Size50s.B11, true
+	case b12:
+		return Size50s.B12, true
+	case b13:
+		return Size50s.B13, true
+	case b14:
+		return Size50s.B14, true
+	case b15:
+		return Size50s.B15, true
+	case b16:
+		return Size50s.B16, true
+	case b17:
+		return Size50s.B17, true
+	case b18:
+		return Size50s.B18, true
+	case b19:
+		return Size50s.B19, true
+	case b20:
+		return Size50s.B20, true
+	case b21:
+		return Size50s.B21, true
+	case b22:
+		return Size50s.B22, true
+	case b23:
+		return Size50s.B23, true
+	case b24:
+		return Size50s.B24, true
+	case b25:
+		return Size50s.B25, true
+	case b26:
+		return Size50s.B26, true
+	case b27:
+		return Size50s.B27, true
+	case b28:
+		return Size50s.B28, true
+	case b29:
+		return Size50s.B29, true
+	case b30:
+		return Size50s.B30, true
+	case b31:
+		return Size50s.B31, true
+	case b32:
+		return Size50s.B32, true
+	case b33:
+		return Size50s.B33, true
+	case b34:
+		return Size50s.B34, true
+	case b35:
+		return Size50s.B35, true
+	case b36:
+		return Size50s.B36, true
+	case b37:
+		return Size50s.B37, true
+	case b38:
+		return Size50s.B38, true
+	case b39:
+		return Size50s.B39, true
+	case b40:
+		return Size50s.B40, true
+	case b41:
+		return Size50s.B41, true
+	case b42:
+		return Size50s.B42, true
+	case b43:
+		return Size50s.B43, true
+	case b44:
+		return Size50s.B44, true
+	case b45:
+		return Size50s.B45, true
+	case b46:
+		return Size50s.B46, true
+	case b47:
+		return Size50s.B47, true
+	case b48:
+		return Size50s.B48, true
+	case b49:
+		return Size50s.B49, true
+	}
+	var zero Size50
+	return zero, false
+}
+
+// All container methods for convenience
+func (t size50Container) All() iter.Seq[Size50] {
+	return Size50{}.All()
+}
+
+func (t size50Container) FromName(name string) (Size50, bool) {
+	return Size50{}.FromName(name)
+}
+
+func (t size50Container) FromValue(value int) (Size50, bool) {
+	return Size50{}.FromValue(value)
+}
+
+// MarshalJSON implements the json.Marshaler interface for Size50.
+func (t Size50) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(t.size50), 10), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Size50.
+func (t *Size50) UnmarshalJSON(data []byte) error {
+	// Known values in canonical form are decoded without enums.UnmarshalJSON
+	if v, err := strconv.ParseInt(string(data), 10, 64); err == nil {
+		var buf [20]byte
+		if string(strconv.AppendInt(buf[:0], v, 10)) == string(data) {
+			if result, ok := t.FromValue(int(v)); ok {
+				*t = result
+				return nil
+			}
+		}
+	}
+	result, err := enums.UnmarshalJSON(*t, data)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+
+// =================================================================================================
+// Size500
+// =================================================================================================
+
+// Size500 is a type that represents a single enum value.
+// It combines the core information about the enum constant and its defined fields.
+type Size500 struct {
+	size500
+}
+
+// Verify that Size500 implements the Enum interface
+var _ enums.Enum[int, Size500] = Size500{}
+
+// size500Container is the container for all enum values.
+// It is private and should not be used directly use the public methods on the Size500 type.
+type size500Container struct {
+	C0   Size500
+	C1   Size500
+	C2   Size500
+	C3   Size500
+	C4   Size500
+	C5   Size500
+	C6   Size500
+	C7   Size500
+	C8   Size500
+	C9   Size500
+	C10  Size500
+	C11  Size500
+	C12  Size500
+	C13  Size500
+	C14  Size500
+	C15  Size500
+	C16  Size500
+	C17  Size500
+	C18  Size500
+	C19  Size500
+	C20  Size500
+	C21  Size500
+	C22  Size500
+	C23  Size500
+	C24  Size500
+	C25  Size500
+	C26  Size500
+	C27  Size500
+	C28  Size500
+	C29  Size500
+	C30  Size500
+	C31  Size500
+	C32  Size500
+	C33  Size500
+	C34  Size500
+	C35  Size500
+	C36  Size500
+	C37  Size500
+	C38  Size500
+	C39  Size500
+	C40  Size500
+	C41  Size500
+	C42  Size500
+	C43  Size500
+	C44  Size500
+	C45  Size500
+	C46  Size500
+	C47  Size500
+	C48  Size500
+	C49  Size500
+	C50  Size500
+	C51  Size500
+	C52  Size500
+	C53  Size500
+	C54  Size500
+	C55  Size500
+	C56  Size500
+	C57  Size500
+	C58  Size500
+	C59  Size500
+	C60  Size500
+	C61  Size500
+	C62  Size500
+	C63  Size500
+	C64  Size500
+	C65  Size500
+	C66  Size500
+	C67  Size500
+	C68  Size500
+	C69  Size500
+	C70  Size500
+	C71  Size500
+	C72  Size500
+	C73  Size500
+	C74  Size500
+	C75  Size500
+	C76  Size500
+	C77  Size500
+	C78  Size500
+	C79  Size500
+	C80  Size500
+	C81  Size500
+	C82  Size500
+	C83  Size500
+	C84  Size500
+	C85  Size500
+	C86  Size500
+	C87  Size500
+	C88  Size500
+	C89  Size500
+	C90  Size500
+	C91  Size500
+	C92  Size500
+	C93  Size500
+	C94  Size500
+	C95  Size500
+	C96  Size500
+	C97  Size500
+	C98  Size500
+	C99  Size500
+	C100 Size500
+	C101 Size500
+	C102 Size500
+	C103 Size500
+	C104 Size500
+	C105 Size500
+	C106 Size500
+	C107 Size500
+	C108 Size500
+	C109 Size500
+	C110 Size500
+	C111 Size500
+	C112 Size500
+	C113 Size500
+	C114 Size500
+	C115 Size500
+	C116 Size500
+	C117 Size500
+	C118 Size500
+	C119 Size500
+	C120 Size500
+	C121 Size500
+	C122 Size500
+	C123 Size500
+	C124 Size500
+	C125 Size500
+	C126 Size500
+	C127 Size500
+	C128 Size500
+	C129 Size500
+	C130 Size500
+	C131 Size500
+	C132 Size500
+	C133 Size500
+	C134 Size500
+	C135 Size500
+	C136 Size500
+	C137 Size500
+	C138 Size500
+	C139 Size500
+	C140 Size500
+	C141 Size500
+	C142 Size500
+	C143 Size500
+	C144 Size500
+	C145 Size500
+	C146 Size500
+	C147 Size500
+	C148 Size500
+	C149 Size500
+	C150 Size500
+	C151 Size500
+	C152 Size500
+	C153 Size500
+	C154 Size500
+	C155 Size500
+	C156 Size500
+	C157 Size500
+	C158 Size500
+	C159 Size500
+	C160 Size500
+	C161 Size500
+	C162 Size500
+	C163 Size500
+	C164 Size500
+	C165 Size500
+	C166 Size500
+	C167 Size500
+	C168 Size500
+	C169 Size500
+	C170 Size500
+	C171 Size500
+	C172 Size500
+	C173 Size500
+	C174 Size500
+	C175 Size500
+	C176 Size500
+	C177 Size500
+	C178 Size500
+	C179 Size500
+	C180 Size500
+	C181 Size500
+	C182 Size500
+	C183 Size500
+	C184 Size500
+	C185 Size500
+	C186 Size500
+	C187 Size500
+	C188 Size500
+	C189 Size500
+	C190 Size500
+	C191 Size500
+	C192 Size500
+	C193 Size500
+	C194 Size500
+	C195 Size500
+	C196 Size500
+	C197 Size500
+	C198 Size500
+	C199 Size500
+	C200 Size500
+	C201 Size500
+	C202 Size500
+	C203 Size500
+	C204 Size500
+	C205 Size500
+	C206 Size500
+	C207 Size500
+	C208 Size500
+	C209 Size500
+	C210 Size500
+	C211 Size500
+	C212 Size500
+	C213 Size500
+	C214 Size500
+	C215 Size500
+	C216 Size500
+	C217 Size500
+	C218 Size500
+	C219 Size500
+	C220 Size500
+	C221 Size500
+	C222 Size500
+	C223 Size500
+	C224 Size500
+	C225 Size500
+	C226 Size500
+	C227 Size500
+	C228 Size500
+	C229 Size500
+	C230 Size500
+	C231 Size500
+	C232 Size500
+	C233 Size500
+	C234 Size500
+	C235 Size500
+	C236 Size500
+	C237 Size500
+	C238 Size500
+	C239 Size500
+	C240 Size500
+	C241 Size500
+	C242 Size500
+	C243 Size500
+	C244 Size500
+	C245 Size500
+	C246 Size500
+	C247 Size500
+	C248 Size500
+	C249 Size500
+	C250 Size500
+	C251 Size500
+	C252 Size500
+	C253 Size500
+	C254 Size500
+	C255 Size500
+	C256 Size500
+	C257 Size500
+	C258 Size500
+	C259 Size500
+	C260 Size500
+	C261 Size500
+	C262 Size500
+	C263 Size500
+	C264 Size500
+	C265 Size500
+	C266 Size500
+	C267 Size500
+	C268 Size500
+	C269 Size500
+	C270 Size500
+	C271 Size500
+	C272 Size500
+	C273 Size500
+	C274 Size500
+	C275 Size500
+	C276 Size500
+	C277 Size500
+	C278 Size500
+	C279 Size500
+	C280 Size500
+	C281 Size500
+	C282 Size500
+	C283 Size500
+	C284 Size500
+	C285 Size500
+	C286 Size500
+	C287 Size500
+	C288 Size500
+	C289 Size500
+	C290 Size500
+	C291 Size500
+	C292 Size500
+	C293 Size500
+	C294 Size500
+	C295 Size500
+	C296 Size500
+	C297 Size500
+	C298 Size500
+	C299 Size500
+	C300 Size500
+	C301 Size500
+	C302 Size500
+	C303 Size500
+	C304 Size500
+	C305 Size500
+	C306 Size500
+	C307 Size500
+	C308 Size500
+	C309 Size500
+	C310 Size500
+	C311 Size500
+	C312 Size500
+	C313 Size500
+	C314 Size500
+	C315 Size500
+	C316 Size500
+	C317 Size500
+	C318 Size500
+	C319 Size500
+	C320 Size500
+	C321 Size500
+	C322 Size500
+	C323 Size500
+	C324 Size500
+	C325 Size500
+	C326 Size500
+	C327 Size500
+	C328 Size500
+	C329 Size500
+	C330 Size500
+	C331 Size500
+	C332 Size500
+	C333 Size500
+	C334 Size500
+	C335 Size500
+	C336 Size500
+	C337 Size500
+	C338 Size500
+	C339 Size500
+	C340 Size500
+	C341 Size500
+	C342 Size500
+	C343 Size500
+	C344 Size500
+	C345 Size500
+	C346 Size500
+	C347 Size500
+	C348 Size500
+	C349 Size500
+	C350 Size500
+	C351 Size500
+	C352 Size500
+	C353 Size500
+	C354 Size500
+	C355 Size500
+	C356 Size500
+	C357 Size500
+	C358 Size500
+	C359 Size500
+	C360 Size500
+	C361 Size500
+	C362 Size500
+	C363 Size500
+	C364 Size500
+	C365 Size500
+	C366 Size500
+	C367 Size500
+	C368 Size500
+	C369 Size500
+	C370 Size500
+	C371 Size500
+	C372 Size500
+	C373 Size500
+	C374 Size500
+	C375 Size500
+	C376 Size500
+	C377 Size500
+	C378 Size500
+	C379 Size500
+	C380 Size500
+	C381 Size500
+	C382 Size500
+	C383 Size500
+	C384 Size500
+	C385 Size500
+	C386 Size500
+	C387 Size500
+	C388 Size500
+	C389 Size500
+	C390 Size500
+	C391 Size500
+	C392 Size500
+	C393 Size500
+	C394 Size500
+	C395 Size500
+	C396 Size500
+	C397 Size500
+	C398 Size500
+	C399 Size500
+	C400 Size500
+	C401 Size500
+	C402 Size500
+	C403 Size500
+	C404 Size500
+	C405 Size500
+	C406 Size500
+	C407 Size500
+	C408 Size500
+	C409 Size500
+	C410 Size500
+	C411 Size500
+	C412 Size500
+	C413 Size500
+	C414 Size500
+	C415 Size500
+	C416 Size500
+	C417 Size500
+	C418 Size500
+	C419 Size500
+	C420 Size500
+	C421 Size500
+	C422 Size500
+	C423 Size500
+	C424 Size500
+	C425 Size500
+	C426 Size500
+	C427 Size500
+	C428 Size500
+	C429 Size500
+	C430 Size500
+	C431 Size500
+	C432 Size500
+	C433 Size500
+	C434 Size500
+	C435 Size500
+	C436 Size500
+	C437 Size500
+	C438 Size500
+	C439 Size500
+	C440 Size500
+	C441 Size500
+	C442 Size500
+	C443 Size500
+	C444 Size500
+	C445 Size500
+	C446 Size500
+	C447 Size500
+	C448 Size500
+	C449 Size500
+	C450 Size500
+	C451 Size500
+	C452 Size500
+	C453 Size500
+	C454 Size500
+	C455 Size500
+	C456 Size500
+	C457 Size500
+	C458 Size500
+	C459 Size500
+	C460 Size500
+	C461 Size500
+	C462 Size500
+	C463 Size500
+	C464 Size500
+	C465 Size500
+	C466 Size500
+	C467 Size500
+	C468 Size500
+	C469 Size500
+	C470 Size500
+	C471 Size500
+	C472 Size500
+	C473 Size500
+	C474 Size500
+	C475 Size500
+	C476 Size500
+	C477 Size500
+	C478 Size500
+	C479 Size500
+	C480 Size500
+	C481 Size500
+	C482 Size500
+	C483 Size500
+	C484 Size500
+	C485 Size500
+	C486 Size500
+	C487 Size500
+	C488 Size500
+	C489 Size500
+	C490 Size500
+	C491 Size500
+	C492 Size500
+	C493 Size500
+	C494 Size500
+	C495 Size500
+	C496 Size500
+	C497 Size500
+	C498 Size500
+	C499 Size500
+}
+
+// Size500s is a main entry point using the Size500 type.
+// It is a container for all enum values and provides a convenient way to access all enum values and perform
+// operations, with convenience methods for common use cases.
+var Size500s = size500Container{
+	C0:   Size500{c0},
+	C1:   Size500{c1},
+	C2:   Size500{c2},
+	C3:   Size500{c3},
+	C4:   Size500{c4},
+	C5:   Size500{c5},
+	C6:   Size500{c6},
+	C7:   Size500{c7},
+	C8:   Size500{c8},
+	C9:   Size500{c9},
+	C10:  Size500{c10},
+	C11:  Size500{c11},
+	C12:  Size500{c12},
+	C13:  Size500{c13},
+	C14:  Size500{c14},
+	C15:  Size500{c15},
+	C16:  Size500{c16},
+	C17:  Size500{c17},
+	C18:  Size500{c18},
+	C19:  Size500{c19},
+	C20:  Size500{c20},
+	C21:  Size500{c21},
+	C22:  Size500{c22},
+	C23:  Size500{c23},
+	C24:  Size500{c24},
+	C25:  Size500{c25},
+	C26:  Size500{c26},
+	C27:  Size500{c27},
+	C28:  Size500{c28},
+	C29:  Size500{c29},
+	C30:  Size500{c30},
+	C31:  Size500{c31},
+	C32:  Size500{c32},
+	C33:  Size500{c33},
+	C34:  Size500{c34},
+	C35:  Size500{c35},
+	C36:  Size500{c36},
+	C37:  Size500{c37},
+	C38:  Size500{c38},
+	C39:  Size500{c39},
+	C40:  Size500{c40},
+	C41:  Size500{c41},
+	C42:  Size500{c42},
+	C43:  Size500{c43},
+	C44:  Size500{c44},
+	C45:  Size500{c45},
+	C46:  Size500{c46},
+	C47:  Size500{c47},
+	C48:  Size500{c48},
+	C49:  Size500{c49},
+	C50:  Size500{c50},
+	C51:  Size500{c51},
+	C52:  Size500{c52},
+	C53:  Size500{c53},
+	C54:  Size500{c54},
+	C55:  Size500{c55},
+	C56:  Size500{c56},
+	C57:  Size500{c57},
+	C58:  Size500{c58},
+	C59:  Size500{c59},
+	C60:  Size500{c60},
+	C61:  Size500{c61},
+	C62:  Size500{c62},
+	C63:  Size500{c63},
+	C64:  Size500{c64},
+	C65:  Size500{c65},
+	C66:  Size500{c66},
+	C67:  Size500{c67},
+	C68:  Size500{c68},
+	C69:  Size500{c69},
+	C70:  Size500{c70},
+	C71:  Size500{c71},
+	C72:  Size500{c72},
+	C73:  Size500{c73},
+	C74:  Size500{c74},
+	C75:  Size500{c75},
+	C76:  Size500{c76},
+	C77:  Size500{c77},
+	C78:  Size500{c78},
+	C79:  Size500{c79},
+	C80:  Size500{c80},
+	C81:  Size500{c81},
+	C82:  Size500{c82},
+	C83:  Size500{c83},
+	C84:  Size500{c84},
+	C85:  Size500{c85},
+	C86:  Size500{c86},
+	C87:  Size500{c87},
+	C88:  Size500{c88},
+	C89:  Size500{c89},
+	C90:  Size500{c90},
+	C91:  Size500{c91},
+	C92:  Size500{c92},
+	C93:  Size500{c93},
+	C94:  Size500{c94},
+	C95:  Size500{c95},
+	C96:  Size500{c96},
+	C97:  Size500{c97},
+	C98:  Size500{c98},
+	C99:  Size500{c99},
+	C100: Size500{c100},
+	C101: Size500{c101},
+	C102: Size500{c102},
+	C103: Size500{c103},
+	C104: Size500{c104},
+	C105: Size500{c105},
+	C106: Size500{c106},
+	C107: Size500{c107},
+	C108: Size500{c108},
+	C109: Size500{c109},
+	C110: Size500{c110},
+	C111: Size500{c111},
+	C112: Size500{c112},
+	C113: Size500{c113},
+	C114: Size500{c114},
+	C115: Size500{c115},
+	C116: Size500{c116},
+	C117: Size500{c117},
+	C118: Size500{c118},
+	C119: Size500{c119},
+	C120: Size500{c120},
+	C121: Size500{c121},
+	C122: Size500{c122},
+	C123: Size500{c123},
+	C124: Size500{c124},
+	C125: Size500{c125},
+	C126: Size500{c126},
+	C127: Size500{c127},
+	C128: Size500{c128},
+	C129: Size500{c129},
+	C130: Size500{c130},
+	C131: Size500{c131},
+	C132: Size500{c132},
+	C133: Size500{c133},
+	C134: Size500{c134},
+	C135: Size500{c135},
+	C136: Size500{c136},
+	C137: Size500{c137},
+	C138: Size500{c138},
+	C139: Size500{c139},
+	C140: Size500{c140},
+	C141: Size500{c141},
+	C142: Size500{c142},
+	C143: Size500{c143},
+	C144: Size500{c144},
+	C145: Size500{c145},
+	C146: Size500{c146},
+	C147: Size500{c147},
+	C148: Size500{c148},
+	C149: Size500{c149},
+	C150: Size500{c150},
+	C151: Size500{c151},
+	C152: Size500{c152},
+	C153: Size500{c153},
+	C154: Size500{c154},
+	C155: Size500{c155},
+	C156: Size500{c156},
+	C157: Size500{c157},
+	C158: Size500{c158},
+	C159: Size500{c159},
+	C160: Size500{c160},
+	C161: Size500{c161},
+	C162: Size500{c162},
+	C163: Size500{c163},
+	C164: Size500{c164},
+	C165: Size500{c165},
+	C166: Size500{c166},
+	C167: Size500{c167},
+	C168: Size500{c168},
+	C169: Size500{c169},
+	C170: Size500{c170},
+	C171: Size500{c171},
+	C172: Size500{c172},
+	C173: Size500{c173},
+	C174: Size500{c174},
+	C175: Size500{c175},
+	C176: Size500{c176},
+	C177: Size500{c177},
+	C178: Size500{c178},
+	C179: Size500{c179},
+	C180: Size500{c180},
+	C181: Size500{c181},
+	C182: Size500{c182},
+	C183: Size500{c183},
+	C184: Size500{c184},
+	C185: Size500{c185},
+	C186: Size500{c186},
+	C187: Size500{c187},
+	C188: Size500{c188},
+	C189: Size500{c189},
+	C190: Size500{c190},
+	C191: Size500{c191},
+	C192: Size500{c192},
+	C193: Size500{c193},
+	C194: Size500{c194},
+	C195: Size500{c195},
+	C196: Size500{c196},
+	C197: Size500{c197},
+	C198: Size500{c198},
+	C199: Size500{c199},
+	C200: Size500{c200},
+	C201: Size500{c201},
+	C202: Size500{c202},
+	C203: Size500{c203},
+	C204: Size500{c204},
+	C205: Size500{c205},
+	C206: Size500{c206},
+	C207: Size500{c207},
+	C208: Size500{c208},
+	C209: Size500{c209},
+	C210: Size500{c210},
+	C211: Size500{c211},
+	C212: Size500{c212},
+	C213: Size500{c213},
+	C214: Size500{c214},
+	C215: Size500{c215},
+	C216: Size500{c216},
+	C217: Size500{c217},
+	C218: Size500{c218},
+	C219: Size500{c219},
+	C220: Size500{c220},
+	C221: Size500{c221},
+	C222: Size500{c222},
+	C223: Size500{c223},
+	C224: Size500{c224},
+	C225: Size500{c225},
+	C226: Size500{c226},
+	C227: Size500{c227},
+	C228: Size500{c228},
+	C229: Size500{c229},
+	C230: Size500{c230},
+	C231: Size500{c231},
+	C232: Size500{c232},
+	C233: Size500{c233},
+	C234: Size500{c234},
+	C235: Size500{c235},
+	C236: Size500{c236},
+	C237: Size500{c237},
+	C238: Size500{c238},
+	C239: Size500{c239},
+	C240: Size500{c240},
+	C241: Size500{c241},
+	C242: Size500{c242},
+	C243: Size500{c243},
+	C244: Size500{c244},
+	C245: Size500{c245},
+	C246: Size500{c246},
+	C247: Size500{c247},
+	C248: Size500{c248},
+	C249: Size500{c249},
+	C250: Size500{c250},
+	C251: Size500{c251},
+	C252: Size500{c252},
+	C253: Size500{c253},
+	C254: Size500{c254},
+	C255: Size500{c255},
+	C256: Size500{c256},
+	C257: Size500{c257},
+	C258: Size500{c258},
+	C259: Size500{c259},
+	C260: Size500{c260},
+	C261: Size500{c261},
+	C262: Size500{c262},
+	C263: Size500{c263},
+	C264: Size500{c264},
+	C265: Size500{c265},
+	C266: Size500{c266},
+	C267: Size500{c267},
+	C268: Size500{c268},
+	C269: Size500{c269},
+	C270: Size500{c270},
+	C271: Size500{c271},
+	C272: Size500{c272},
+	C273: Size500{c273},
+	C274: Size500{c274},
+	C275: Size500{c275},
+	C276: Size500{c276},
+	C277: Size500{c277},
+	C278: Size500{c278},
+	C279: Size500{c279},
+	C280: Size500{c280},
+	C281: Size500{c281},
+	C282: Size500{c282},
+	C283: Size500{c283},
+	C284: Size500{c284},
+	C285: Size500{c285},
+	C286: Size500{c286},
+	C287: Size500{c287},
+	C288: Size500{c288},
+	C289: Size500{c289},
+	C290: Size500{c290},
+	C291: Size500{c291},
+	C292: Size500{c292},
+	C293: Size500{c293},
+	C294: Size500{c294},
+	C295: Size500{c295},
+	C296: Size500{c296},
+	C297: Size500{c297},
+	C298: Size500{c298},
+	C299: Size500{c299},
+	C300: Size500{c300},
+	C301: Size500{c301},
+	C302: Size500{c302},
+	C303: Size500{c303},
+	C304: Size500{c304},
+	C305: Size500{c305},
+	C306: Size500{c306},
+	C307: Size500{c307},
+	C308: Size500{c308},
+	C309: Size500{c309},
+	C310: Size500{c310},
+	C311: Size500{c311},
+	C312: Size500{c312},
+	C313: Size500{c313},
+	C314: Size500{c314},
+	C315: Size500{c315},
+	C316: Size500{c316},
+	C317: Size500{c317},
+	C318: Size500{c318},
+	C319: Size500{c319},
+	C320: Size500{c320},
+	C321: Size500{c321},
+	C322: Size500{c322},
+	C323: Size500{c323},
+	C324: Size500{c324},
+	C325: Size500{c325},
+	C326: Size500{c326},
+	C327: Size500{c327},
+	C328: Size500{c328},
+	C329: Size500{c329},
+	C330: Size500{c330},
+	C331: Size500{c331},
+	C332: Size500{c332},
+	C333: Size500{c333},
+	C334: Size500{c334},
+	C335: Size500{c335},
+	C336: Size500{c336},
+	C337: Size500{c337},
+	C338: Size500{c338},
+	C339: Size500{c339},
+	C340: Size500{c340},
+	C341: Size500{c341},
+	C342: Size500{c342},
+	C343: Size500{c343},
+	C344: Size500{c344},
+	C345: Size500{c345},
+	C346: Size500{c346},
+	C347: Size500{c347},
+	C348: Size500{c348},
+	C349: Size500{c349},
+	C350: Size500{c350},
+	C351: Size500{c351},
+	C352: Size500{c352},
+	C353: Size500{c353},
+	C354: Size500{c354},
+	C355: Size500{c355},
+	C356: Size500{c356},
+	C357: Size500{c357},
+	C358: Size500{c358},
+	C359: Size500{c359},
+	C360: Size500{c360},
+	C361: Size500{c361},
+	C362: Size500{c362},
+	C363: Size500{c363},
+	C364: Size500{c364},
+	C365: Size500{c365},
+	C366: Size500{c366},
+	C367: Size500{c367},
+	C368: Size500{c368},
+	C369: Size500{c369},
+	C370: Size500{c370},
+	C371: Size500{c371},
+	C372: Size500{c372},
+	C373: Size500{c373},
+	C374: Size500{c374},
+	C375: Size500{c375},
+	C376: Size500{c376},
+	C377: Size500{c377},
+	C378: Size500{c378},
+	C379: Size500{c379},
+	C380: Size500{c380},
+	C381: Size500{c381},
+	C382: Size500{c382},
+	C383: Size500{c383},
+	C384: Size500{c384},
+	C385: Size500{c385},
+	C386: Size500{c386},
+	C387: Size500{c387},
+	C388: Size500{c388},
+	C389: Size500{c389},
+	C390: Size500{c390},
+	C391: Size500{c391},
+	C392: Size500{c392},
+	C393: Size500{c393},
+	C394: Size500{c394},
+	C395: Size500{c395},
+	C396: Size500{c396},
+	C397: Size500{c397},
+	C398: Size500{c398},
+	C399: Size500{c399},
+	C400: Size500{c400},
+	C401: Size500{c401},
+	C402: Size500{c402},
+	C403: Size500{c403},
+	C404: Size500{c404},
+	C405: Size500{c405},
+	C406: Size500{c406},
+	C407: Size500{c407},
+	C408: Size500{c408},
+	C409: Size500{c409},
+	C410: Size500{c410},
+	C411: Size500{c411},
+	C412: Size500{c412},
+	C413: Size500{c413},
+	C414: Size500{c414},
+	C415: Size500{c415},
+	C416: Size500{c416},
+	C417: Size500{c417},
+	C418: Size500{c418},
+	C419: Size500{c419},
+	C420: Size500{c420},
+	C421: Size500{c421},
+	C422: Size500{c422},
+	C423: Size500{c423},
+	C424: Size500{c424},
+	C425: Size500{c425},
+	C426: Size500{c426},
+	C427: Size500{c427},
+	C428: Size500{c428},
+	C429: Size500{c429},
+	C430: Size500{c430},
+	C431: Size500{c431},
+	C432: Size500{c432},
+	C433: Size500{c433},
+	C434: Size500{c434},
+	C435: Size500{c435},
+	C436: Size500{c436},
+	C437: Size500{c437},
+	C438: Size500{c438},
+	C439: Size500{c439},
+	C440: Size500{c440},
+	C441: Size500{c441},
+	C442: Size500{c442},
+	C443: Size500{c443},
+	C444: Size500{c444},
+	C445: Size500{c445},
+	C446: Size500{c446},
+	C447: Size500{c447},
+	C448: Size500{c448},
+	C449: Size500{c449},
+	C450: Size500{c450},
+	C451: Size500{c451},
+	C452: Size500{c452},
+	C453: Size500{c453},
+	C454: Size500{c454},
+	C455: Size500{c455},
+	C456: Size500{c456},
+	C457: Size500{c457},
+	C458: Size500{c458},
+	C459: Size500{c459},
+	C460: Size500{c460},
+	C461: Size500{c461},
+	C462: Size500{c462},
+	C463: Size500{c463},
+	C464: Size500{c464},
+	C465: Size500{c465},
+	C466: Size500{c466},
+	C467: Size500{c467},
+	C468: Size500{c468},
+	C469: Size500{c469},
+	C470: Size500{c470},
+	C471: Size500{c471},
+	C472: Size500{c472},
+	C473: Size500{c473},
+	C474: Size500{c474},
+	C475: Size500{c475},
+	C476: Size500{c476},
+	C477: Size500{c477},
+	C478: Size500{c478},
+	C479: Size500{c479},
+	C480: Size500{c480},
+	C481: Size500{c481},
+	C482: Size500{c482},
+	C483: Size500{c483},
+	C484: Size500{c484},
+	C485: Size500{c485},
+	C486: Size500{c486},
+	C487: Size500{c487},
+	C488: Size500{c488},
+	C489: Size500{c489},
+	C490: Size500{c490},
+	C491: Size500{c491},
+	C492: Size500{c492},
+	C493: Size500{c493},
+	C494: Size500{c494},
+	C495: Size500{c495},
+	C496: Size500{c496},
+	C497: Size500{c497},
+	C498: Size500{c498},
+	C499: Size500{c499},
+}
+
+// size500NamesMap maps enum values to their names array
+var size500NamesMap = map[Size500][]string{
+	Size500s.C0: {
+		"c0",
+	},
+	Size500s.C1: {
+		"c1",
+	},
+	Size500s.C2: {
+		"c2",
+	},
+	Size500s.C3: {
+		"c3",
+	},
+	Size500s.C4: {
+		"c4",
+	},
+	Size500s.C5: {
+		"c5",
+	},
+	Size500s.C6: {
+		"c6",
+	},
+	Size500s.C7: {
+		"c7",
+	},
+	Size500s.C8: {
+		"c8",
+	},
+	Size500s.C9: {
+		"c9",
+	},
+	Size500s.C10: {
+		"c10",
+	},
+	Size500s.C11: {
+		"c11",
+	},
+	Size500s.C12: {
+		"c12",
+	},
+	Size500s.C13: {
+		"c13",
+	},
+	Size500s.C14: {
+		"c14",
+	},
+	Size500s.C15: {
+		"c15",
+	},
+	Size500s.C16: {
+		"c16",
+	},
+	Size500s.C17: {
+		"c17",
+	},
+	Size500s.C18: {
+		"c18",
+	},
+	Size500s.C19: {
+		"c19",
+	},
+	Size500s.C20: {
+		"c20",
+	},
+	Size500s.C21: {
+		"c21",
+	},
+	Size500s.C22: {
+		"c22",
+	},
+	Size500s.C23: {
+		"c23",
+	},
+	Size500s.C24: {
+		"c24",
+	},
+	Size500s.C25: {
+		"c25",
+	},
+	Size500s.C26: {
+		"c26",
+	},
+	Size500s.C27: {
+		"c27",
+	},
+	Size500s.C28: {
+		"c28",
+	},
+	Size500s.C29: {
+		"c29",
+	},
+	Size500s.C30: {
+		"c30",
+	},
+	Size500s.C31: {
+		"c31",
+	},
+	Size500s.C32: {
+		"c32",
+	},
+	Size500s.C33: {
+		"c33",
+	},
+	Size500s.C34: {
+		"c34",
+	},
+	Size500s.C35: {
+		"c35",
+	},
+	Size500s.C36: {
+		"c36",
+	},
+	Size500s.C37: {
+		"c37",
+	},
+	Size500s.C38: {
+		"c38",
+	},
+	Size500s.C39: {
+		"c39",
+	},
+	Size500s.C40: {
+		"c40",
+	},
+	Size500s.C41: {
+		"c41",
+	},
+	Size500s.C42: {
+		"c42",
+	},
+	Size500s.C43: {
+		"c43",
+	},
+	Size500s.C44: {
+		"c44",
+	},
+	Size500s.C45: {
+		"c45",
+	},
+	Size500s.C46: {
+		"c46",
+	},
+	Size500s.C47: {
+		"c47",
+	},
+	Size500s.C48: {
+		"c48",
+	},
+	Size500s.C49: {
+		"c49",
+	},
+	Size500s.C50: {
+		"c50",
+	},
+	Size500s.C51: {
+		"c51",
+	},
+	Size500s.C52: {
+		"c52",
+	},
+	Size500s.C53: {
+		"c53",
+	},
+	Size500s.C54: {
+		"c54",
+	},
+	Size500s.C55: {
+		"c55",
+	},
+	Size500s.C56: {
+		"c56",
+	},
+	Size500s.C57: {
+		"c57",
+	},
+	Size500s.C58: {
+		"c58",
+	},
+	Size500s.C59: {
+		"c59",
+	},
+	Size500s.C60: {
+		"c60",
+	},
+	Size500s.C61: {
+		"c61",
+	},
+	Size500s.C62: {
+		"c62",
+	},
+	Size500s.C63: {
+		"c63",
+	},
+	Size500s.C64: {
+		"c64",
+	},
+	Size500s.C65: {
+		"c65",
+	},
+	Size500s.C66: {
+		"c66",
+	},
+	Size500s.C67: {
+		"c67",
+	},
+	Size500s.C68: {
+		"c68",
+	},
+	Size500s.C69: {
+		"c69",
+	},
+	Size500s.C70: {
+		"c70",
+	},
+	Size500s.C71: {
+		"c71",
+	},
+	Size500s.C72: {
+		"c72",
+	},
+	Size500s.C73: {
+		"c73",
+	},
+	Size500s.C74: {
+		"c74",
+	},
+	Size500s.C75: {
+		"c75",
+	},
+	Size500s.C76: {
+		"c76",
+	},
+	Size500s.C77: {
+		"c77",
+	},
+	Size500s.C78: {
+		"c78",
+	},
+	Size500s.C79: {
+		"c79",
+	},
+	Size500s.C80: {
+		"c80",
+	},
+	Size500s.C81: {
+		"c81",
+	},
+	Size500s.C82: {
+		"c82",
+	},
+	Size500s.C83: {
+		"c83",
+	},
+	Size500s.C84: {
+		"c84",
+	},
+	Size500s.C85: {
+		"c85",
+	},
+	Size500s.C86: {
+		"c86",
+	},
+	Size500s.C87: {
+		"c87",
+	},
+	Size500s.C88: {
+		"c88",
+	},
+	Size500s.C89: {
+		"c89",
+	},
+	Size500s.C90: {
+		"c90",
+	},
+	Size500s.C91: {
+		"c91",
+	},
+	Size500s.C92: {
+		"c92",
+	},
+	Size500s.C93: {
+		"c93",
+	},
+	Size500s.C94: {
+		"c94",
+	},
+	Size500s.C95: {
+		"c95",
+	},
+	Size500s.C96: {
+		"c96",
+	},
+	Size500s.C97: {
+		"c97",
+	},
+	Size500s.C98: {
+		"c98",
+	},
+	Size500s.C99: {
+		"c99",
+	},
+	Size500s.C100: {
+		"c100",
+	},
+	Size500s.C101: {
+		"c101",
+	},
+	Size500s.C102: {
+		"c102",
+	},
+	Size500s.C103: {
+		"c103",
+	},
+	Size500s.C104: {
+		"c104",
+	},
+	Size500s.C105: {
+		"c105",
+	},
+	Size500s.C106: {
+		"c106",
+	},
+	Size500s.C107: {
+		"c107",
+	},
+	Size500s.C108: {
+		"c108",
+	},
+	Size500s.C109: {
+		"c109",
+	},
+	Size500s.C110: {
+		"c110",
+	},
+	Size500s.C111: {
+		"c111",
+	},
+	Size500s.C112: {
+		"c112",
+	},
+	Size500s.C113: {
+		"c113",
+	},
+	Size500s.C114: {
+		"c114",
+	},
+	Size500s.C115: {
+		"c115",
+	},
+	Size500s.C116: {
+		"c116",
+	},
+	Size500s.C117: {
+		"c117",
+	},
+	Size500s.C118: {
+		"c118",
+	},
+	Size500s.C119: {
+		"c119",
+	},
+	Size500s.C120: {
+		"c120",
+	},
+	Size500s.C121: {
+		"c121",
+	},
+	Size500s.C122: {
+		"c122",
+	},
+	Size500s.C123: {
+		"c123",
+	},
+	Size500s.C124: {
+		"c124",
+	},
+	Size500s.C125: {
+		"c125",
+	},
+	Size500s.C126: {
+		"c126",
+	},
+	Size500s.C127: {
+		"c127",
+	},
+	Size500s.C128: {
+		"c128",
+	},
+	Size500s.C129: {
+		"c129",
+	},
+	Size500s.C130: {
+		"c130",
+	},
+	Size500s.C131: {
+		"c131",
+	},
+	Size500s.C132: {
+		"c132",
+	},
+	Size500s.C133: {
+		"c133",
+	},
+	Size500s.C134: {
+		"c134",
+	},
+	Size500s.C135: {
+		"c135",
+	},
+	Size500s.C136: {
+		"c136",
+	},
+	Size500s.C137: {
+		"c137",
+	},
+	Size500s.C138: {
+		"c138",
+	},
+	Size500s.C139: {
+		"c139",
+	},
+	Size500s.C140: {
+		"c140",
+	},
+	Size500s.C141: {
+		"c141",
+	},
+	Size500s.C142: {
+		"c142",
+	},
+	Size500s.C143: {
+		"c143",
+	},
+	Size500s.C144: {
+		"c144",
+	},
+	Size500s.C145: {
+		"c145",
+	},
+	Size500s.C146: {
+		"c146",
+	},
+	Size500s.C147: {
+		"c147",
+	},
+	Size500s.C148: {
+		"c148",
+	},
+	Size500s.C149: {
+		"c149",
+	},
+	Size500s.C150: {
+		"c150",
+	},
+	Size500s.C151: {
+		"c151",
+	},
+	Size500s.C152: {
+		"c152",
+	},
+	Size500s.C153: {
+		"c153",
+	},
+	Size500s.C154: {
+		"c154",
+	},
+	Size500s.C155: {
+		"c155",
+	},
+	Size500s.C156: {
+		"c156",
+	},
+	Size500s.C157: {
+		"c157",
+	},
+	Size500s.C158: {
+		"c158",
+	},
+	Size500s.C159: {
+		"c159",
+	},
+	Size500s.C160: {
+		"c160",
+	},
+	Size500s.C161: {
+		"c161",
+	},
+	Size500s.C162: {
+		"c162",
+	},
+	Size500s.C163: {
+		"c163",
+	},
+	Size500s.C164: {
+		"c164",
+	},
+	Size500s.C165: {
+		"c165",
+	},
+	Size500s.C166: {
+		"c166",
+	},
+	Size500s.C167: {
+		"c167",
+	},
+	Size500s.C168: {
+		"c168",
+	},
+	Size500s.C169: {
+		"c169",
+	},
+	Size500s.C170: {
+		"c170",
+	},
+	Size500s.C171: {
+		"c171",
+	},
+	Size500s.C172: {
+		"c172",
+	},
+	Size500s.C173: {
+		"c173",
+	},
+	Size500s.C174: {
+		"c174",
+	},
+	Size500s.C175: {
+		"c175",
+	},
+	Size500s.C176: {
+		"c176",
+	},
+	Size500s.C177: {
+		"c177",
+	},
+	Size500s.C178: {
+		"c178",
+	},
+	Size500s.C179: {
+		"c179",
+	},
+	Size500s.C180: {
+		"c180",
+	},
+	Size500s.C181: {
+		"c181",
+	},
+	Size500s.C182: {
+		"c182",
+	},
+	Size500s.C183: {
+		"c183",
+	},
+	Size500s.C184: {
+		"c184",
+	},
+	Size500s.C185: {
+		"c185",
+	},
+	Size500s.C186: {
+		"c186",
+	},
+	Size500s.C187: {
+		"c187",
+	},
+	Size500s.C188: {
+		"c188",
+	},
+	Size500s.C189: {
+		"c189",
+	},
+	Size500s.C190: {
+		"c190",
+	},
+	Size500s.C191: {
+		"c191",
+	},
+	Size500s.C192: {
+		"c192",
+	},
+	Size500s.C193: {
+		"c193",
+	},
+	Size500s.C194: {
+		"c194",
+	},
+	Size500s.C195: {
+		"c195",
+	},
+	Size500s.C196: {
+		"c196",
+	},
+	Size500s.C197: {
+		"c197",
+	},
+	Size500s.C198: {
+		"c198",
+	},
+	Size500s.C199: {
+		"c199",
+	},
+	Size500s.C200: {
+		"c200",
+	},
+	Size500s.C201: {
+		"c201",
+	},
+	Size500s.C202: {
+		"c202",
+	},
+	Size500s.C203: {
+		"c203",
+	},
+	Size500s.C204: {
+		"c204",
+	},
+	Size500s.C205: {
+		"c205",
+	},
+	Size500s.C206: {
+		"c206",
+	},
+	Size500s.C207: {
+		"c207",
+	},
+	Size500s.C208: {
+		"c208",
+	},
+	Size500s.C209: {
+		"c209",
+	},
+	Size500s.C210: {
+		"c210",
+	},
+	Size500s.C211: {
+		"c211",
+	},
+	Size500s.C212: {
+		"c212",
+	},
+	Size500s.C213: {
+		"c213",
+	},
+	Size500s.C214: {
+		"c214",
+	},
+	Size500s.C215: {
+		"c215",
+	},
+	Size500s.C216: {
+		"c216",
+	},
+	Size500s.C217: {
+		"c217",
+	},
+	Size500s.C218: {
+		"c218",
+	},
+	Size500s.C219: {
+		"c219",
+	},
+	Size500s.C220: {
+		"c220",
+	},
+	Size500s.C221: {
+		"c221",
+	},
+	Size500s.C222: {
+		"c222",
+	},
+	Size500s.C223: {
+		"c223",
+	},
+	Size500s.C224: {
+		"c224",
+	},
+	Size500s.C225: {
+		"c225",
+	},
+	Size500s.C226: {
+		"c226",
+	},
+	Size500s.C227: {
+		"c227",
+	},
+	Size500s.C228: {
+		"c228",
+	},
+	Size500s.C229: {
+		"c229",
+	},
+	Size500s.C230: {
+		"c230",
+	},
+	Size500s.C231: {
+		"c231",
+	},
+	Size500s.C232: {
+		"c232",
+	},
+	Size500s.C233: {
+		"c233",
+	},
+	Size500s.C234: {
+		"c234",
+	},
+	Size500s.C235: {
+		"c235",
+	},
+	Size500s.C236: {
+		"c236",
+	},
+	Size500s.C237: {
+		"c237",
+	},
+	Size500s.C238: {
+		"c238",
+	},
+	Size500s.C239: {
+		"c239",
+	},
+	Size500s.C240: {
+		"c240",
+	},
+	Size500s.C241: {
+		"c241",
+	},
+	Size500s.C242: {
+		"c242",
+	},
+	Size500s.C243: {
+		"c243",
+	},
+	Size500s.C244: {
+		"c244",
+	},
+	Size500s.C245: {
+		"c245",
+	},
+	Size500s.C246: {
+		"c246",
+	},
+	Size500s.C247: {
+		"c247",
+	},
+	Size500s.C248: {
+		"c248",
+	},
+	Size500s.C249: {
+		"c249",
+	},
+	Size500s.C250: {
+		"c250",
+	},
+	Size500s.C251: {
+		"c251",
+	},
+	Size500s.C252: {
+		"c252",
+	},
+	Size500s.C253: {
+		"c253",
+	},
+	Size500s.C254: {
+		"c254",
+	},
+	Size500s.C255: {
+		"c255",
+	},
+	Size500s.C256: {
+		"c256",
+	},
+	Size500s.C257: {
+		"c257",
+	},
+	Size500s.C258: {
+		"c258",
+	},
+	Size500s.C259: {
+		"c259",
+	},
+	Size500s.C260: {
+		"c260",
+	},
+	Size500s.C261: {
+		"c261",
+	},
+	Size500s.C262: {
+		"c262",
+	},
+	Size500s.C263: {
+		"c263",
+	},
+	Size500s.C264: {
+		"c264",
+	},
+	Size500s.C265: {
+		"c265",
+	},
+	Size500s.C266: {
+		"c266",
+	},
+	Size500s.C267: {
+		"c267",
+	},
+	Size500s.C268: {
+		"c268",
+	},
+	Size500s.C269: {
+		"c269",
+	},
+	Size500s.C270: {
+		"c270",
+	},
+	Size500s.C271: {
+		"c271",
+	},
+	Size500s.C272: {
+		"c272",
+	},
+	Size500s.C273: {
+		"c273",
+	},
+	Size500s.C274: {
+		"c274",
+	},
+	Size500s.C275: {
+		"c275",
+	},
+	Size500s.C276: {
+		"c276",
+	},
+	Size500s.C277: {
+		"c277",
+	},
+	Size500s.C278: {
+		"c278",
+	},
+	Size500s.C279: {
+		"c279",
+	},
+	Size500s.C280: {
+		"c280",
+	},
+	Size500s.C281: {
+		"c281",
+	},
+	Size500s.C282: {
+		"c282",
+	},
+	Size500s.C283: {
+		"c283",
+	},
+	Size500s.C284: {
+		"c284",
+	},
+	Size500s.C285: {
+		"c285",
+	},
+	Size500s.C286: {
+		"c286",
+	},
+	Size500s.C287: {
+		"c287",
+	},
+	Size500s.C288: {
+		"c288",
+	},
+	Size500s.C289: {
+		"c289",
+	},
+	Size500s.C290: {
+		"c290",
+	},
+	Size500s.C291: {
+		"c291",
+	},
+	Size500s.C292: {
+		"c292",
+	},
+	Size500s.C293: {
+		"c293",
+	},
+	Size500s.C294: {
+		"c294",
+	},
+	Size500s.C295: {
+		"c295",
+	},
+	Size500s.C296: {
+		"c296",
+	},
+	Size500s.C297: {
+		"c297",
+	},
+	Size500s.C298: {
+		"c298",
+	},
+	Size500s.C299: {
+		"c299",
+	},
+	Size500s.C300: {
+		"c300",
+	},
+	Size500s.C301: {
+		"c301",
+	},
+	Size500s.C302: {
+		"c302",
+	},
+	Size500s.C303: {
+		"c303",
+	},
+	Size500s.C304: {
+		"c304",
+	},
+	Size500s.C305: {
+		"c305",
+	},
+	Size500s.C306: {
+		"c306",
+	},
+	Size500s.C307: {
+		"c307",
+	},
+	Size500s.C308: {
+		"c308",
+	},
+	Size500s.C309: {
+		"c309",
+	},
+	Size500s.C310: {
+		"c310",
+	},
+	Size500s.C311: {
+		"c311",
+	},
+	Size500s.C312: {
+		"c312",
+	},
+	Size500s.C313: {
+		"c313",
+	},
+	Size500s.C314: {
+		"c314",
+	},
+	Size500s.C315: {
+		"c315",
+	},
+	Size500s.C316: {
+		"c316",
+	},
+	Size500s.C317: {
+		"c317",
+	},
+	Size500s.C318: {
+		"c318",
+	},
+	Size500s.C319: {
+		"c319",
+	},
+	Size500s.C320: {
+		"c320",
+	},
+	Size500s.C321: {
+		"c321",
+	},
+	Size500s.C322: {
+		"c322",
+	},
+	Size500s.C323: {
+		"c323",
+	},
+	Size500s.C324: {
+		"c324",
+	},
+	Size500s.C325: {
+		"c325",
+	},
+	Size500s.C326: {
+		"c326",
+	},
+	Size500s.C327: {
+		"c327",
+	},
+	Size500s.C328: {
+		"c328",
+	},
+	Size500s.C329: {
+		"c329",
+	},
+	Size500s.C330: {
+		"c330",
+	},
+	Size500s.C331: {
+		"c331",
+	},
+	Size500s.C332: {
+		"c332",
+	},
+	Size500s.C333: {
+		"c333",
+	},
+	Size500s.C334: {
+		"c334",
+	},
+	Size500s.C335: {
+		"c335",
+	},
+	Size500s.C336: {
+		"c336",
+	},
+	Size500s.C337: {
+		"c337",
+	},
+	Size500s.C338: {
+		"c338",
+	},
+	Size500s.C339: {
+		"c339",
+	},
+	Size500s.C340: {
+		"c340",
+	},
+	Size500s.C341: {
+		"c341",
+	},
+	Size500s.C342: {
+		"c342",
+	},
+	Size500s.C343: {
+		"c343",
+	},
+	Size500s.C344: {
+		"c344",
+	},
+	Size500s.C345: {
+		"c345",
+	},
+	Size500s.C346: {
+		"c346",
+	},
+	Size500s.C347: {
+		"c347",
+	},
+	Size500s.C348: {
+		"c348",
+	},
+	Size500s.C349: {
+		"c349",
+	},
+	Size500s.C350: {
+		"c350",
+	},
+	Size500s.C351: {
+		"c351",
+	},
+	Size500s.C352: {
+		"c352",
+	},
+	Size500s.C353: {
+		"c353",
+	},
+	Size500s.C354: {
+		"c354",
+	},
+	Size500s.C355: {
+		"c355",
+	},
+	Size500s.C356: {
+		"c356",
+	},
+	Size500s.C357: {
+		"c357",
+	},
+	Size500s.C358: {
+		"c358",
+	},
+	Size500s.C359: {
+		"c359",
+	},
+	Size500s.C360: {
+		"c360",
+	},
+	Size500s.C361: {
+		"c361",
+	},
+	Size500s.C362: {
+		"c362",
+	},
+	Size500s.C363: {
+		"c363",
+	},
+	Size500s.C364: {
+		"c364",
+	},
+	Size500s.C365: {
+		"c365",
+	},
+	Size500s.C366: {
+		"c366",
+	},
+	Size500s.C367: {
+		"c367",
+	},
+	Size500s.C368: {
+		"c368",
+	},
+	Size500s.C369: {
+		"c369",
+	},
+	Size500s.C370: {
+		"c370",
+	},
+	Size500s.C371: {
+		"c371",
+	},
+	Size500s.C372: {
+		"c372",
+	},
+	Size500s.C373: {
+		"c373",
+	},
+	Size500s.C374: {
+		"c374",
+	},
+	Size500s.C375: {
+		"c375",
+	},
+	Size500s.C376: {
+		"c376",
+	},
+	Size500s.C377: {
+		"c377",
+	},
+	Size500s.C378: {
+		"c378",
+	},
+	Size500s.C379: {
+		"c379",
+	},
+	Size500s.C380: {
+		"c380",
+	},
+	Size500s.C381: {
+		"c381",
+	},
+	Size500s.C382: {
+		"c382",
+	},
+	Size500s.C383: {
+		"c383",
+	},
+	Size500s.C384: {
+		"c384",
+	},
+	Size500s.C385: {
+		"c385",
+	},
+	Size500s.C386: {
+		"c386",
+	},
+	Size500s.C387: {
+		"c387",
+	},
+	Size500s.C388: {
+		"c388",
+	},
+	Size500s.C389: {
+		"c389",
+	},
+	Size500s.C390: {
+		"c390",
+	},
+	Size500s.C391: {
+		"c391",
+	},
+	Size500s.C392: {
+		"c392",
+	},
+	Size500s.C393: {
+		"c393",
+	},
+	Size500s.C394: {
+		"c394",
+	},
+	Size500s.C395: {
+		"c395",
+	},
+	Size500s.C396: {
+		"c396",
+	},
+	Size500s.C397: {
+		"c397",
+	},
+	Size500s.C398: {
+		"c398",
+	},
+	Size500s.C399: {
+		"c399",
+	},
+	Size500s.C400: {
+		"c400",
+	},
+	Size500s.C401: {
+		"c401",
+	},
+	Size500s.C402: {
+		"c402",
+	},
+	Size500s.C403: {
+		"c403",
+	},
+	Size500s.C404: {
+		"c404",
+	},
+	Size500s.C405: {
+		"c405",
+	},
+	Size500s.C406: {
+		"c406",
+	},
+	Size500s.C407: {
+		"c407",
+	},
+	Size500s.C408: {
+		"c408",
+	},
+	Size500s.C409: {
+		"c409",
+	},
+	Size500s.C410: {
+		"c410",
+	},
+	Size500s.C411: {
+		"c411",
+	},
+	Size500s.C412: {
+		"c412",
+	},
+	Size500s.C413: {
+		"c413",
+	},
+	Size500s.C414: {
+		"c414",
+	},
+	Size500s.C415: {
+		"c415",
+	},
+	Size500s.C416: {
+		"c416",
+	},
+	Size500s.C417: {
+		"c417",
+	},
+	Size500s.C418: {
+		"c418",
+	},
+	Size500s.C419: {
+		"c419",
+	},
+	Size500s.C420: {
+		"c420",
+	},
+	Size500s.C421: {
+		"c421",
+	},
+	Size500s.C422: {
+		"c422",
+	},
+	Size500s.C423: {
+		"c423",
+	},
+	Size500s.C424: {
+		"c424",
+	},
+	Size500s.C425: {
+		"c425",
+	},
+	Size500s.C426: {
+		"c426",
+	},
+	Size500s.C427: {
+		"c427",
+	},
+	Size500s.C428: {
+		"c428",
+	},
+	Size500s.C429: {
+		"c429",
+	},
+	Size500s.C430: {
+		"c430",
+	},
+	Size500s.C431: {
+		"c431",
+	},
+	Size500s.C432: {
+		"c432",
+	},
+	Size500s.C433: {
+		"c433",
+	},
+	Size500s.C434: {
+		"c434",
+	},
+	Size500s.C435: {
+		"c435",
+	},
+	Size500s.C436: {
+		"c436",
+	},
+	Size500s.C437: {
+		"c437",
+	},
+	Size500s.C438: {
+		"c438",
+	},
+	Size500s.C439: {
+		"c439",
+	},
+	Size500s.C440: {
+		"c440",
+	},
+	Size500s.C441: {
+		"c441",
+	},
+	Size500s.C442: {
+		"c442",
+	},
+	Size500s.C443: {
+		"c443",
+	},
+	Size500s.C444: {
+		"c444",
+	},
+	Size500s.C445: {
+		"c445",
+	},
+	Size500s.C446: {
+		"c446",
+	},
+	Size500s.C447: {
+		"c447",
+	},
+	Size500s.C448: {
+		"c448",
+	},
+	Size500s.C449: {
+		"c449",
+	},
+	Size500s.C450: {
+		"c450",
+	},
+	Size500s.C451: {
+		"c451",
+	},
+	Size500s.C452: {
+		"c452",
+	},
+	Size500s.C453: {
+		"c453",
+	},
+	Size500s.C454: {
+		"c454",
+	},
+	Size500s.C455: {
+		"c455",
+	},
+	Size500s.C456: {
+		"c456",
+	},
+	Size500s.C457: {
+		"c457",
+	},
+	Size500s.C458: {
+		"c458",
+	},
+	Size500s.C459: {
+		"c459",
+	},
+	Size500s.C460: {
+		"c460",
+	},
+	Size500s.C461: {
+		"c461",
+	},
+	Size500s.C462: {
+		"c462",
+	},
+	Size500s.C463: {
+		"c463",
+	},
+	Size500s.C464: {
+		"c464",
+	},
+	Size500s.C465: {
+		"c465",
+	},
+	Size500s.C466: {
+		"c466",
+	},
+	Size500s.C467: {
+		"c467",
+	},
+	Size500s.C468: {
+		"c468",
+	},
+	Size500s.C469: {
+		"c469",
+	},
+	Size500s.C470: {
+		"c470",
+	},
+	Size500s.C471: {
+		"c471",
+	},
+	Size500s.C472: {
+		"c472",
+	},
+	Size500s.C473: {
+		"c473",
+	},
+	Size500s.C474: {
+		"c474",
+	},
+	Size500s.C475: {
+		"c475",
+	},
+	Size500s.C476: {
+		"c476",
+	},
+	Size500s.C477: {
+		"c477",
+	},
+	Size500s.C478: {
+		"c478",
+	},
+	Size500s.C479: {
+		"c479",
+	},
+	Size500s.C480: {
+		"c480",
+	},
+	Size500s.C481: {
+		"c481",
+	},
+	Size500s.C482: {
+		"c482",
+	},
+	Size500s.C483: {
+		"c483",
+	},
+	Size500s.C484: {
+		"c484",
+	},
+	Size500s.C485: {
+		"c485",
+	},
+	Size500s.C486: {
+		"c486",
+	},
+	Size500s.C487: {
+		"c487",
+	},
+	Size500s.C488: {
+		"c488",
+	},
+	Size500s.C489: {
+		"c489",
+	},
+	Size500s.C490: {
+		"c490",
+	},
+	Size500s.C491: {
+		"c491",
+	},
+	Size500s.C492: {
+		"c492",
+	},
+	Size500s.C493: {
+		"c493",
+	},
+	Size500s.C494: {
+		"c494",
+	},
+	Size500s.C495: {
+		"c495",
+	},
+	Size500s.C496: {
+		"c496",
+	},
+	Size500s.C497: {
+		"c497",
+	},
+	Size500s.C498: {
+		"c498",
+	},
+	Size500s.C499: {
+		"c499",
+	},
+}
+
+// size500NameIndex maps every name to its enum value
+var size500NameIndex = map[string]Size500{
+	"c0":   Size500s.C0,
+	"c1":   Size500s.C1,
+	"c2":   Size500s.C2,
+	"c3":   Size500s.C3,
+	"c4":   Size500s.C4,
+	"c5":   Size500s.C5,
+	"c6":   Size500s.C6,
+	"c7":   Size500s.C7,
+	"c8":   Size500s.C8,
+	"c9":   Size500s.C9,
+	"c10":  Size500s.C10,
+	"c11":  Size500s.C11,
+	"c12":  Size500s.C12,
+	"c13":  Size500s.C13,
+	"c14":  Size500s.C14,
+	"c15":  Size500s.C15,
+	"c16":  Size500s.C16,
+	"c17":  Size500s.C17,
+	"c18":  Size500s.C18,
+	"c19":  Size500s.C19,
+	"c20":  Size500s.C20,
+	"c21":  Size500s.C21,
+	"c22":  Size500s.C22,
+	"c23":  Size500s.C23,
+	"c24":  Size500s.C24,
+	"c25":  Size500s.C25,
+	"c26":  Size500s.C26,
+	"c27":  Size500s.C27,
+	"c28":  Size500s.C28,
+	"c29":  Size500s.C29,
+	"c30":  Size500s.C30,
+	"c31":  Size500s.C31,
+	"c32":  Size500s.C32,
+	"c33":  Size500s.C33,
+	"c34":  Size500s.C34,
+	"c35":  Size500s.C35,
+	"c36":  Size500s.C36,
+	"c37":  Size500s.C37,
+	"c38":  Size500s.C38,
+	"c39":  Size500s.C39,
+	"c40":  Size500s.C40,
+	"c41":  Size500s.C41,
+	"c42":  Size500s.C42,
+	"c43":  Size500s.C43,
+	"c44":  Size500s.C44,
+	"c45":  Size500s.C45,
+	"c46":  Size500s.C46,
+	"c47":  Size500s.C47,
+	"c48":  Size500s.C48,
+	"c49":  Size500s.C49,
+	"c50":  Size500s.C50,
+	"c51":  Size500s.C51,
+	"c52":  Size500s.C52,
+	"c53":  Size500s.C53,
+	"c54":  Size500s.C54,
+	"c55":  Size500s.C55,
+	"c56":  Size500s.C56,
+	"c57":  Size500s.C57,
+	"c58":  Size500s.C58,
+	"c59":  Size500s.C59,
+	"c60":  Size500s.C60,
+	"c61":  Size500s.C61,
+	"c62":  Size500s.C62,
+	"c63":  Size500s.C63,
+	"c64":  Size500s.C64,
+	"c65":  Size500s.C65,
+	"c66":  Size500s.C66,
+	"c67":  Size500s.C67,
+	"c68":  Size500s.C68,
+	"c69":  Size500s.C69,
+	"c70":  Size500s.C70,
+	"c71":  Size500s.C71,
+	"c72":  Size500s.C72,
+	"c73":  Size500s.C73,
+	"c74":  Size500s.C74,
+	"c75":  Size500s.C75,
+	"c76":  Size500s.C76,
+	"c77":  Size500s.C77,
+	"c78":  Size500s.C78,
+	"c79":  Size500s.C79,
+	"c80":  Size500s.C80,
+	"c81":  Size500s.C81,
+	"c82":  Size500s.C82,
+	"c83":  Size500s.C83,
+	"c84":  Size500s.C84,
+	"c85":  Size500s.C85,
+	"c86":  Size500s.C86,
+	"c87":  Size500s.C87,
+	"c88":  Size500s.C88,
+	"c89":  Size500s.C89,
+	"c90":  Size500s.C90,
+	"c91":  Size500s.C91,
+	"c92":  Size500s.C92,
+	"c93":  Size500s.C93,
+	"c94":  Size500s.C94,
+	"c95":  Size500s.C95,
+	"c96":  Size500s.C96,
+	"c97":  Size500s.C97,
+	"c98":  Size500s.C98,
+	"c99":  Size500s.C99,
+	"c100": Size500s.C100,
+	"c101": Size500s.C101,
+	"c102": Size500s.C102,
+	"c103": Size500s.C103,
+	"c104": Size500s.C104,
+	"c105": Size500s.C105,
+	"c106": Size500s.C106,
+	"c107": Size500s.C107,
+	"c108": Size500s.C108,
+	"c109": Size500s.C109,
+	"c110": Size500s.C110,
+	"c111": Size500s.C111,
+	"c112": Size500s.C112,
+	"c113": Size500s.C113,
+	"c114": Size500s.C114,
+	"c115": Size500s.C115,
+	"c116": Size500s.C116,
+	"c117": Size500s.C117,
+	"c118": Size500s.C118,
+	"c119": Size500s.C119,
+	"c120": Size500s.C120,
+	"c121": Size500s.C121,
+	"c122": Size500s.C122,
+	"c123": Size500s.C123,
+	"c124": Size500s.C124,
+	"c125": Size500s.C125,
+	"c126": Size500s.C126,
+	"c127": Size500s.C127,
+	"c128": Size500s.C128,
+	"c129": Size500s.C129,
+	"c130": Size500s.C130,
+	"c131": Size500s.C131,
+	"c132": Size500s.C132,
+	"c133": Size500s.C133,
+	"c134": Size500s.C134,
+	"c135": Size500s.C135,
+	"c136": Size500s.C136,
+	"c137": Size500s.C137,
+	"c138": Size500s.C138,
+	"c139": Size500s.C139,
+	"c140": Size500s.C140,
+	"c141": Size500s.C141,
+	"c142": Size500s.C142,
+	"c143": Size500s.C143,
+	"c144": Size500s.C144,
+	"c145": Size500s.C145,
+	"c146": Size500s.C146,
+	"c147": Size500s.C147,
+	"c148": Size500s.C148,
+	"c149": Size500s.C149,
+	"c150": Size500s.C150,
+	"c151": Size500s.C151,
+	"c152": Size500s.C152,
+	"c153": Size500s.C153,
+	"c154": Size500s.C154,
+	"c155": Size500s.C155,
+	"c156": Size500s.C156,
+	"c157": Size500s.C157,
+	"c158": Size500s.C158,
+	"c159": Size500s.C159,
+	"c160": Size500s.C160,
+	"c161": Size500s.C161,
+	"c162": Size500s.C162,
+	"c163": Size500s.C163,
+	"c164": Size500s.C164,
+	"c165": Size500s.C165,
+	"c166": Size500s.C166,
+	"c167": Size500s.C167,
+	"c168": Size500s.C168,
+	"c169": Size500s.C169,
+	"c170": Size500s.C170,
+	"c171": Size500s.C171,
+	"c172": Size500s.C172,
+	"c173": Size500s.C173,
+	"c174": Size500s.C174,
+	"c175": Size500s.C175,
+	"c176": Size500s.C176,
+	"c177": Size500s.C177,
+	"c178": Size500s.C178,
+	"c179": Size500s.C179,
+	"c180": Size500s.C180,
+	"c181": Size500s.C181,
+	"c182": Size500s.C182,
+	"c183": Size500s.C183,
+	"c184": Size500s.C184,
+	"c185": Size500s.C185,
+	"c186": Size500s.C186,
+	"c187": Size500s.C187,
+	"c188": Size500s.C188,
+	"c189": Size500s.C189,
+	"c190": Size500s.C190,
+	"c191": Size500s.C191,
+	"c192": Size500s.C192,
+	"c193": Size500s.C193,
+	"c194": Size500s.C194,
+	"c195": Size500s.C195,
+	"c196": Size500s.C196,
+	"c197": Size500s.C197,
+	"c198": Size500s.C198,
+	"c199": Size500s.C199,
+	"c200": Size500s.C200,
+	"c201": Size500s.C201,
+	"c202": Size500s.C202,
+	"c203": Size500s.C203,
+	"c204": Size500s.C204,
+	"c205": Size500s.C205,
+	"c206": Size500s.C206,
+	"c207": Size500s.C207,
+	"c208": Size500s.C208,
+	"c209": Size500s.C209,
+	"c210": Size500s.C210,
+	"c211": Size500s.C211,
+	"c212": Size500s.C212,
+	"c213": Size500s.C213,
+	"c214": Size500s.C214,
+	"c215": Size500s.C215,
+	"c216": Size500s.C216,
+	"c217": Size500s.C217,
+	"c218": Size500s.C218,
+	"c219": Size500s.C219,
+	"c220": Size500s.C220,
+	"c221": Size500s.C221,
+	"c222": Size500s.C222,
+	"c223": Size500s.C223,
+	"c224": Size500s.C224,
+	"c225": Size500s.C225,
+	"c226": Size500s.C226,
+	"c227": Size500s.C227,
+	"c228": Size500s.C228,
+	"c229": Size500s.C229,
+	"c230": Size500s.C230,
+	"c231": Size500s.C231,
+	"c232": Size500s.C232,
+	"c233": Size500s.C233,
+	"c234": Size500s.C234,
+	"c235": Size500s.C235,
+	"c236": Size500s.C236,
+	"c237": Size500s.C237,
+	"c238": Size500s.C238,
+	"c239": Size500s.C239,
+	"c240": Size500s.C240,
+	"c241": Size500s.C241,
+	"c242": Size500s.C242,
+	"c243": Size500s.C243,
+	"c244": Size500s.C244,
+	"c245": Size500s.C245,
+	"c246": Size500s.C246,
+	"c247": Size500s.C247,
+	"c248": Size500s.C248,
+	"c249": Size500s.C249,
+	"c250": Size500s.C250,
+	"c251": Size500s.C251,
+	"c252": Size500s.C252,
+	"c253": Size500s.C253,
+	"c254": Size500s.C254,
+	"c255": Size500s.C255,
+	"c256": Size500s.C256,
+	"c257": Size500s.C257,
+	"c258": Size500s.C258,
+	"c259": Size500s.C259,
+	"c260": Size500s.C260,
+	"c261": Size500s.C261,
+	"c262": Size500s.C262,
+	"c263": Size500s.C263,
+	"c264": Size500s.C264,
+	"c265": Size500s.C265,
+	"c266": Size500s.C266,
+	"c267": Size500s.C267,
+	"c268": Size500s.C268,
+	"c269": Size500s.C269,
+	"c270": Size500s.C270,
+	"c271": Size500s.C271,
+	"c272": Size500s.C272,
+	"c273": Size500s.C273,
+	"c274": Size500s.C274,
+	"c275": Size500s.C275,
+	"c276": Size500s.C276,
+	"c277": Size500s.C277,
+	"c278": Size500s.C278,
+	"c279": Size500s.C279,
+	"c280": Size500s.C280,
+	"c281": Size500s.C281,
+	"c282": Size500s.C282,
+	"c283": Size500s.C283,
+	"c284": Size500s.C284,
+	"c285": Size500s.C285,
+	"c286": Size500s.C286,
+	"c287": Size500s.C287,
+	"c288": Size500s.C288,
+	"c289": Size500s.C289,
+	"c290": Size500s.C290,
+	"c291": Size500s.C291,
+	"c292": Size500s.C292,
+	"c293": Size500s.C293,
+	"c294": Size500s.C294,
+	"c295": Size500s.C295,
+	"c296": Size500s.C296,
+	"c297": Size500s.C297,
+	"c298": Size500s.C298,
+	"c299": Size500s.C299,
+	"c300": Size500s.C300,
+	"c301": Size500s.C301,
+	"c302": Size500s.C302,
+	"c303": Size500s.C303,
+	"c304": Size500s.C304,
+	"c305": Size500s.C305,
+	"c306": Size500s.C306,
+	"c307": Size500s.C307,
+	"c308": Size500s.C308,
+	"c309": Size500s.C309,
+	"c310": Size500s.C310,
+	"c311": Size500s.C311,
+	"c312": Size500s.C312,
+	"c313": Size500s.C313,
+	"c314": Size500s.C314,
+	"c315": Size500s.C315,
+	"c316": Size500s.C316,
+	"c317": Size500s.C317,
+	"c318": Size500s.C318,
+	"c319": Size500s.C319,
+	"c320": Size500s.C320,
+	"c321": Size500s.C321,
+	"c322": Size500s.C322,
+	"c323": Size500s.C323,
+	"c324": Size500s.C324,
+	"c325": Size500s.C325,
+	"c326": Size500s.C326,
+	"c327": Size500s.C327,
+	"c328": Size500s.C328,
+	"c329": Size500s.C329,
+	"c330": Size500s.C330,
+	"c331": Size500s.C331,
+	"c332": Size500s.C332,
+	"c333": Size500s.C333,
+	"c334": Size500s.C334,
+	"c335": Size500s.C335,
+	"c336": Size500s.C336,
+	"c337": Size500s.C337,
+	"c338": Size500s.C338,
+	"c339": Size500s.C339,
+	"c340": Size500s.C340,
+	"c341": Size500s.C341,
+	"c342": Size500s.C342,
+	"c343": Size500s.C343,
+	"c344": Size500s.C344,
+	"c345": Size500s.C345,
+	"c346": Size500s.C346,
+	"c347": Size500s.C347,
+	"c348": Size500s.C348,
+	"c349": Size500s.C349,
+	"c350": Size500s.C350,
+	"c351": Size500s.C351,
+	"c352": Size500s.C352,
+	"c353": Size500s.C353,
+	"c354": Size500s.C354,
+	"c355": Size500s.C355,
+	"c356": Size500s.C356,
+	"c357": Size500s.C357,
+	"c358": Size500s.C358,
+	"c359": Size500s.C359,
+	"c360": Size500s.C360,
+	"c361": Size500s.C361,
+	"c362": Size500s.C362,
+	"c363": Size500s.C363,
+	"c364": Size500s.C364,
+	"c365": Size500s.C365,
+	"c366": Size500s.C366,
+	"c367": Size500s.C367,
+	"c368": Size500s.C368,
+	"c369": Size500s.C369,
+	"c370": Size500s.C370,
+	"c371": Size500s.C371,
+	"c372": Size500s.C372,
+	"c373": Size500s.C373,
+	"c374": Size500s.C374,
+	"c375": Size500s.C375,
+	"c376": Size500s.C376,
+	"c377": Size500s.C377,
+	"c378": Size500s.C378,
+	"c379": Size500s.C379,
+	"c380": Size500s.C380,
+	"c381": Size500s.C381,
+	"c382": Size500s.C382,
+	"c383": Size500s.C383,
+	"c384": Size500s.C384,
+	"c385": Size500s.C385,
+	"c386": Size500s.C386,
+	"c387": Size500s.C387,
+	"c388": Size500s.C388,
+	"c389": Size500s.C389,
+	"c390": Size500s.C390,
+	"c391": Size500s.C391,
+	"c392": Size500s.C392,
+	"c393": Size500s.C393,
+	"c394": Size500s.C394,
+	"c395": Size500s.C395,
+	"c396": Size500s.C396,
+	"c397": Size500s.C397,
+	"c398": Size500s.C398,
+	"c399": Size500s.C399,
+	"c400": Size500s.C400,
+	"c401": Size500s.C401,
+	"c402": Size500s.C402,
+	"c403": Size500s.C403,
+	"c404": Size500s.C404,
+	"c405": Size500s.C405,
+	"c406": Size500s.C406,
+	"c407": Size500s.C407,
+	"c408": Size500s.C408,
+	"c409": Size500s.C409,
+	"c410": Size500s.C410,
+	"c411": Size500s.C411,
+	"c412": Size500s.C412,
+	"c413": Size500s.C413,
+	"c414": Size500s.C414,
+	"c415": Size500s.C415,
+	"c416": Size500s.C416,
+	"c417": Size500s.C417,
+	"c418": Size500s.C418,
+	"c419": Size500s.C419,
+	"c420": Size500s.C420,
+	"c421": Size500s.C421,
+	"c422": Size500s.C422,
+	"c423": Size500s.C423,
+	"c424": Size500s.C424,
+	"c425": Size500s.C425,
+	"c426": Size500s.C426,
+	"c427": Size500s.C427,
+	"c428": Size500s.C428,
+	"c429": Size500s.C429,
+	"c430": Size500s.C430,
+	"c431": Size500s.C431,
+	"c432": Size500s.C432,
+	"c433": Size500s.C433,
+	"c434": Size500s.C434,
+	"c435": Size500s.C435,
+	"c436": Size500s.C436,
+	"c437": Size500s.C437,
+	"c438": Size500s.C438,
+	"c439": Size500s.C439,
+	"c440": Size500s.C440,
+	"c441": Size500s.C441,
+	"c442": Size500s.C442,
+	"c443": Size500s.C443,
+	"c444": Size500s.C444,
+	"c445": Size500s.C445,
+	"c446": Size500s.C446,
+	"c447": Size500s.C447,
+	"c448": Size500s.C448,
+	"c449": Size500s.C449,
+	"c450": Size500s.C450,
+	"c451": Size500s.C451,
+	"c452": Size500s.C452,
+	"c453": Size500s.C453,
+	"c454": Size500s.C454,
+	"c455": Size500s.C455,
+	"c456": Size500s.C456,
+	"c457": Size500s.C457,
+	"c458": Size500s.C458,
+	"c459": Size500s.C459,
+	"c460": Size500s.C460,
+	"c461": Size500s.C461,
+	"c462": Size500s.C462,
+	"c463": Size500s.C463,
+	"c464": Size500s.C464,
+	"c465": Size500s.C465,
+	"c466": Size500s.C466,
+	"c467": Size500s.C467,
+	"c468": Size500s.C468,
+	"c469": Size500s.C469,
+	"c470": Size500s.C470,
+	"c471": Size500s.C471,
+	"c472": Size500s.C472,
+	"c473": Size500s.C473,
+	"c474": Size500s.C474,
+	"c475": Size500s.C475,
+	"c476": Size500s.C476,
+	"c477": Size500s.C477,
+	"c478": Size500s.C478,
+	"c479": Size500s.C479,
+	"c480": Size500s.C480,
+	"c481": Size500s.C481,
+	"c482": Size500s.C482,
+	"c483": Size500s.C483,
+	"c484": Size500s.C484,
+	"c485": Size500s.C485,
+	"c486": Size500s.C486,
+	"c487": Size500s.C487,
+	"c488": Size500s.C488,
+	"c489": Size500s.C489,
+	"c490": Size500s.C490,
+	"c491": Size500s.C491,
+	"c492": Size500s.C492,
+	"c493": Size500s.C493,
+	"c494": Size500s.C494,
+	"c495": Size500s.C495,
+	"c496": Size500s.C496,
+	"c497": Size500s.C497,
+	"c498": Size500s.C498,
+	"c499": Size500s.C499,
+}
+
+// Size500Raw is a type alias for the underlying enum type size500.
+// It provides direct access to the raw enum values for cases where you need
+// to work with the underlying type directly.
+type Size500Raw = size500
+
+// allSlice returns a slice of all enum values.
+func (t size500Container) allSlice() []Size500 {
+	return []Size500{
+		Size500s.C0,
+		Size500s.C1,
+		Size500s.C2,
+		Size500s.C3,
+		Size500s.C4,
+		Size500s.C5,
+		Size500s.C6,
+		Size500s.C7,
+		Size500s.C8,
+		Size500s.C9,
+		Size500s.C10,
+		Size500s.C11,
+		Size500s.C12,
+		Size500s.C13,
+		Size500s.C14,
+		Size500s.C15,
+		Size500s.C16,
+		Size500s.C17,
+		Size500s.C18,
+		Size500s.C19,
+		Size500s.C20,
+		Size500s.C21,
+		Size500s.C22,
+		Size500s.C23,
+		Size500s.C24,
+		Size500s.C25,
+		Size500s.C26,
+		Size500s.C27,
+		Size500s.C28,
+		Size500s.C29,
+		Size500s.C30,
+		Size500s.C31,
+		Size500s.C32,
+		Size500s.C33,
+		Size500s.C34,
+		Size500s.C35,
+		Size500s.C36,
+		Size500s.C37,
+		Size500s.C38,
+		Size500s.C39,
+		Size500s.C40,
+		Size500s.C41,
+		Size500s.C42,
+		Size500s.C43,
+		Size500s.C44,
+		Size500s.C45,
+		Size500s.C46,
+		Size500s.C47,
+		Size500s.C48,
+		Size500s.C49,
+		Size500s.C50,
+		Size500s.C51,
+		Size500s.C52,
+		Size500s.C53,
+		Size500s.C54,
+		Size500s.C55,
+		Size500s.C56,
+		Size500s.C57,
+		Size500s.C58,
+		Size500s.C59,
+		Size500s.C60,
+		Size500s.C61,
+		Size500s.C62,
+		Size500s.C63,
+		Size500s.C64,
+		Size500s.C65,
+		Size500s.C66,
+		Size500s.C67,
+		Size500s.C68,
+		Size500s.C69,
+		Size500s.C70,
+		Size500s.C71,
+		Size500s.C72,
+		Size500s.C73,
+		Size500s.C74,
+		Size500s.C75,
+		Size500s.C76,
+		Size500s.C77,
+		Size500s.C78,
+		Size500s.C79,
+		Size500s.C80,
+		Size500s.C81,
+		Size500s.C82,
+		Size500s.C83,
+		Size500s.C84,
+		Size500s.C85,
+		Size500s.C86,
+		Size500s.C87,
+		Size500s.C88,
+		Size500s.C89,
+		Size500s.C90,
+		Size500s.C91,
+		Size500s.C92,
+		Size500s.C93,
+		Size500s.C94,
+		Size500s.C95,
+		Size500s.C96,
+		Size500s.C97,
+		Size500s.C98,
+		Size500s.C99,
+		Size500s.C100,
+		Size500s.C101,
+		Size500s.C102,
+		Size500s.C103,
+		Size500s.C104,
+		Size500s.C105,
+		Size500s.C106,
+		Size500s.C107,
+		Size500s.C108,
+		Size500s.C109,
+		Size500s.C110,
+		Size500s.C111,
+		Size500s.C112,
+		Size500s.C113,
+		Size500s.C114,
+		Size500s.C115,
+		Size500s.C116,
+		Size500s.C117,
+		Size500s.C118,
+		Size500s.C119,
+		Size500s.C120,
+		Size500s.C121,
+		Size500s.C122,
+		Size500s.C123,
+		Size500s.C124,
+		Size500s.C125,
+		Size500s.C126,
+		Size500s.C127,
+		Size500s.C128,
+		Size500s.C129,
+		Size500s.C130,
+		Size500s.C131,
+		Size500s.C132,
+		Size500s.C133,
+		Size500s.C134,
+		Size500s.C135,
+		Size500s.C136,
+		Size500s.C137,
+		Size500s.C138,
+		Size500s.C139,
+		Size500s.C140,
+		Size500s.C141,
+		Size500s.C142,
+		Size500s.C143,
+		Size500s.C144,
+		Size500s.C145,
+		Size500s.C146,
+		Size500s.C147,
+		Size500s.C148,
+		Size500s.C149,
+		Size500s.C150,
+		Size500s.C151,
+		Size500s.C152,
+		Size500s.C153,
+		Size500s.C154,
+		Size500s.C155,
+		Size500s.C156,
+		Size500s.C157,
+		Size500s.C158,
+		Size500s.C159,
+		Size500s.C160,
+		Size500s.C161,
+		Size500s.C162,
+		Size500s.C163,
+		Size500s.C164,
+		Size500s.C165,
+		Size500s.C166,
+		Size500s.C167,
+		Size500s.C168,
+		Size500s.C169,
+		Size500s.C170,
+		Size500s.C171,
+		Size500s.C172,
+		Size500s.C173,
+		Size500s.C174,
+		Size500s.C175,
+		Size500s.C176,
+		Size500s.C177,
+		Size500s.C178,
+		Size500s.C179,
+		Size500s.C180,
+		Size500s.C181,
+		Size500s.C182,
+		Size500s.C183,
+		Size500s.C184,
+		Size500s.C185,
+		Size500s.C186,
+		Size500s.C187,
+		Size500s.C188,
+		Size500s.C189,
+		Size500s.C190,
+		Size500s.C191,
+		Size500s.C192,
+		Size500s.C193,
+		Size500s.C194,
+		Size500s.C195,
+		Size500s.C196,
+		Size500s.C197,
+		Size500s.C198,
+		Size500s.C199,
+		Size500s.C200,
+		Size500s.C201,
+		Size500s.C202,
+		Size500s.C203,
+		Size500s.C204,
+		Size500s.C205,
+		Size500s.C206,
+		Size500s.C207,
+		Size500s.C208,
+		Size500s.C209,
+		Size500s.C210,
+		Size500s.C211,
+		Size500s.C212,
+		Size500s.C213,
+		Size500s.C214,
+		Size500s.C215,
+		Size500s.C216,
+		Size500s.C217,
+		Size500s.C218,
+		Size500s.C219,
+		Size500s.C220,
+		Size500s.C221,
+		Size500s.C222,
+		Size500s.C223,
+		Size500s.C224,
+		Size500s.C225,
+		Size500s.C226,
+		Size500s.C227,
+		Size500s.C228,
+		Size500s.C229,
+		Size500s.C230,
+		Size500s.C231,
+		Size500s.C232,
+		Size500s.C233,
+		Size500s.C234,
+		Size500s.C235,
+		Size500s.C236,
+		Size500s.C237,
+		Size500s.C238,
+		Size500s.C239,
+		Size500s.C240,
+		Size500s.C241,
+		Size500s.C242,
+		Size500s.C243,
+		Size500s.C244,
+		Size500s.C245,
+		Size500s.C246,
+		Size500s.C247,
+		Size500s.C248,
+		Size500s.C249,
+		Size500s.C250,
+		Size500s.C251,
+		Size500s.C252,
+		Size500s.C253,
+		Size500s.C254,
+		Size500s.C255,
+		Size500s.C256,
+		Size500s.C257,
+		Size500s.C258,
+		Size500s.C259,
+		Size500s.C260,
+		Size500s.C261,
+		Size500s.C262,
+		Size500s.C263,
+		Size500s.C264,
+		Size500s.C265,
+		Size500s.C266,
+		Size500s.C267,
+		Size500s.C268,
+		Size500s.C269,
+		Size500s.C270,
+		Size500s.C271,
+		Size500s.C272,
+		Size500s.C273,
+		Size500s.C274,
+		Size500s.C275,
+		Size500s.C276,
+		Size500s.C277,
+		Size500s.C278,
+		Size500s.C279,
+		Size500s.C280,
+		Size500s.C281,
+		Size500s.C282,
+		Size500s.C283,
+		Size500s.C284,
+		Size500s.C285,
+		Size500s.C286,
+		Size500s.C287,
+		Size500s.C288,
+		Size500s.C289,
+		Size500s.C290,
+		Size500s.C291,
+		Size500s.C292,
+		Size500s.C293,
+		Size500s.C294,
+		Size500s.C295,
+		Size500s.C296,
+		Size500s.C297,
+		Size500s.C298,
+		Size500s.C299,
+		Size500s.C300,
+		Size500s.C301,
+		Size500s.C302,
+		Size500s.C303,
+		Size500s.C304,
+		Size500s.C305,
+		Size500s.C306,
+		Size500s.C307,
+		Size500s.C308,
+		Size500s.C309,
+		Size500s.C310,
+		Size500s.C311,
+		Size500s.C312,
+		Size500s.C313,
+		Size500s.C314,
+		Size500s.C315,
+		Size500s.C316,
+		Size500s.C317,
+		Size500s.C318,
+		Size500s.C319,
+		Size500s.C320,
+		Size500s.C321,
+		Size500s.C322,
+		Size500s.C323,
+		Size500s.C324,
+		Size500s.C325,
+		Size500s.C326,
+		Size500s.C327,
+		Size500s.C328,
+		Size500s.C329,
+		Size500s.C330,
+		Size500s.C331,
+		Size500s.C332,
+		Size500s.C333,
+		Size500s.C334,
+		Size500s.C335,
+		Size500s.C336,
+		Size500s.C337,
+		Size500s.C338,
+		Size500s.C339,
+		Size500s.C340,
+		Size500s.C341,
+		Size500s.C342,
+		Size500s.C343,
+		Size500s.C344,
+		Size500s.C345,
+		Size500s.C346,
+		Size500s.C347,
+		Size500s.C348,
+		Size500s.C349,
+		Size500s.C350,
+		Size500s.C351,
+		Size500s.C352,
+		Size500s.C353,
+		Size500s.C354,
+		Size500s.C355,
+		Size500s.C356,
+		Size500s.C357,
+		Size500s.C358,
+		Size500s.C359,
+		Size500s.C360,
+		Size500s.C361,
+		Size500s.C362,
+		Size500s.C363,
+		Size500s.C364,
+		Size500s.C365,
+		Size500s.C366,
+		Size500s.C367,
+		Size500s.C368,
+		Size500s.C369,
+		Size500s.C370,
+		Size500s.C371,
+		Size500s.C372,
+		Size500s.C373,
+		Size500s.C374,
+		Size500s.C375,
+		Size500s.C376,
+		Size500s.C377,
+		Size500s.C378,
+		Size500s.C379,
+		Size500s.C380,
+		Size500s.C381,
+		Size500s.C382,
+		Size500s.C383,
+		Size500s.C384,
+		Size500s.C385,
+		Size500s.C386,
+		Size500s.C387,
+		Size500s.C388,
+		Size500s.C389,
+		Size500s.C390,
+		Size500s.C391,
+		Size500s.C392,
+		Size500s.C393,
+		Size500s.C394,
+		Size500s.C395,
+		Size500s.C396,
+		Size500s.C397,
+		Size500s.C398,
+		Size500s.C399,
+		Size500s.C400,
+		Size500s.C401,
+		Size500s.C402,
+		Size500s.C403,
+		Size500s.C404,
+		Size500s.C405,
+		Size500s.C406,
+		Size500s.C407,
+		Size500s.C408,
+		Size500s.C409,
+		Size500s.C410,
+		Size500s.C411,
+		Size500s.C412,
+		Size500s.C413,
+		Size500s.C414,
+		Size500s.C415,
+		Size500s.C416,
+		Size500s.C417,
+		Size500s.C418,
+		Size500s.C419,
+		Size500s.C420,
+		Size500s.C421,
+		Size500s.C422,
+		Size500s.C423,
+		Size500s.C424,
+		Size500s.C425,
+		Size500s.C426,
+		Size500s.C427,
+		Size500s.C428,
+		Size500s.C429,
+		Size500s.C430,
+		Size500s.C431,
+		Size500s.C432,
+		Size500s.C433,
+		Size500s.C434,
+		Size500s.C435,
+		Size500s.C436,
+		Size500s.C437,
+		Size500s.C438,
+		Size500s.C439,
+		Size500s.C440,
+		Size500s.C441,
+		Size500s.C442,
+		Size500s.C443,
+		Size500s.C444,
+		Size500s.C445,
+		Size500s.C446,
+		Size500s.C447,
+		Size500s.C448,
+		Size500s.C449,
+		Size500s.C450,
+		Size500s.C451,
+		Size500s.C452,
+		Size500s.C453,
+		Size500s.C454,
+		Size500s.C455,
+		Size500s.C456,
+		Size500s.C457,
+		Size500s.C458,
+		Size500s.C459,
+		Size500s.C460,
+		Size500s.C461,
+		Size500s.C462,
+		Size500s.C463,
+		Size500s.C464,
+		Size500s.C465,
+		Size500s.C466,
+		Size500s.C467,
+		Size500s.C468,
+		Size500s.C469,
+		Size500s.C470,
+		Size500s.C471,
+		Size500s.C472,
+		Size500s.C473,
+		Size500s.C474,
+		Size500s.C475,
+		Size500s.C476,
+		Size500s.C477,
+		Size500s.C478,
+		Size500s.C479,
+		Size500s.C480,
+		Size500s.C481,
+		Size500s.C482,
+		Size500s.C483,
+		Size500s.C484,
+		Size500s.C485,
+		Size500s.C486,
+		Size500s.C487,
+		Size500s.C488,
+		Size500s.C489,
+		Size500s.C490,
+		Size500s.C491,
+		Size500s.C492,
+		Size500s.C493,
+		Size500s.C494,
+		Size500s.C495,
+		Size500s.C496,
+		Size500s.C497,
+		Size500s.C498,
+		Size500s.C499,
+	}
+}
+
+// Val implements the Enum interface.
+func (t Size500) Val() int {
+	return int(t.size500)
+}
+
+// All implements the Enum interface.
+func (t Size500) All() iter.Seq[Size500] {
+	return func(yield func(Size500) bool) {
+		for _, v := range Size500s.allSlice() {
+			if !v.IsValid() {
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// IsValid implements the Enum interface.
+func (t Size500) IsValid() bool {
+	return true
+}
+
+// Name implements the Enum interface.
+// Returns the first name of the enum value.
+func (t Size500) Name() string {
+	if names, ok := size500NamesMap[t]; ok && len(names) > 0 {
+		return names[0]
+	}
+	return ""
+}
+
+// NameWith returns the name at the specified index.
+// If the index is out of bounds, returns the last name.
+func (t Size500) NameWith(idx int) string {
+	names, ok := size500NamesMap[t]
+	if !ok || len(names) == 0 {
+		return ""
+	}
+	if idx < 0 || idx >= len(names) {
+		return names[len(names)-1]
+	}
+	return names[idx]
+}
+
+// Names returns all names of the enum value.
+func (t Size500) Names() []string {
+	if names, ok := size500NamesMap[t]; ok {
+		return names
+	}
+	return []string{}
+}
+
+// String implements the Stringer interface.
+func (t Size500) String() string {
+	if names, ok := size500NamesMap[t]; ok && len(names) > 0 {
+		return names[0]
+	}
+	return fmt.Sprintf("size500(%v)", t.size500)
+}
+
+// SerdeFormat implements the Enum interface.
+func (t Size500) SerdeFormat() enums.Format {
+	return enums.FormatValue
+}
+
+// FromName implements the Enum interface.
+func (t Size500) FromName(name string) (Size500, bool) {
+	if v, ok := size500NameIndex[name]; ok {
+		return v, v.IsValid()
+	}
+	var zero Size500
+	return zero, false
+}
+
+// FromValue implements the Enum interface.
+// Invalid values are never returned.
+func (t Size500) FromValue(value int) (Size500, bool) {
+	switch size500(value) {
+	case c0:
+		return Size500s.C0, true
+	case c1:
+		return Size500s.C1, true
+	case c2:
+		return Size500s.C2, true
+	case c3:
+		return Size500s.C3, true
+	case c4:
+		return Size500s.C4, true
+	case c5:
+		return Size500s.C5, true
+	case c6:
+		return Size500s.C6, true
+	case c7:
+		return Size500s.C7, true
+	case c8:
+		return Size500s.C8, true
+	case c9:
+		return Size500s.C9, true
+	case c10:
+		return Size500s.C10, true
+	case c11:
+		return Size500s.C11, true
+	case c12:
+		return Size500s.C12, true
+	case c13:
+		return Size500s.C13, true
+	case c14:
+		return Size500s.C14, true
+	case c15:
+		return Size500s.C15, true
+	case c16:
+		return Size500s.C16, true
+	case c17:
+		return Size500s.C17, true
+	case c18:
+		return Size500s.C18, true
+	case c19:
+		return Size500s.C19, true
+	case c20:
+		return Size500s.C20, true
+	case c21:
+		return Size500s.C21, true
+	case c22:
+		return Size500s.C22, true
+	case c23:
+		return Size500s.C23, true
+	case c24:
+		return Size500s.C24, true
+	case c25:
+		return Size500s.C25, true
+	case c26:
+		return Size500s.C26, true
+	case c27:
+		return Size500s.C27, true
+	case c28:
+		return Size500s.C28, true
+	case c29:
+		return Size500s.C29, true
+	case c30:
+		return Size500s.C30, true
+	case c31:
+		return Size500s.C31, true
+	case c32:
+		return Size500s.C32, true
+	case c33:
+		return Size500s.C33, true
+	case c34:
+		return Size500s.C34, true
+	case c35:
+		return Size500s.C35, true
+	case c36:
+		return Size500s.C36, true
+	case c37:
+		return Size500s.C37, true
+	case c38:
+		return Size500s.C38, true
+	case c39:
+		return Size500s.C39, true
+	case c40:
+		return Size500s.C40, true
+	case c41:
+		return Size500s.C41, true
+	case c42:
+		return Size500s.C42, true
+	case c43:
+		return Size500s.C43, true
+	case c44:
+		return Size500s.C44, true
+	case c45:
+		return Size500s.C45, true
+	case c46:
+		return Size500s.C46, true
+	case c47:
+		return Size500s.C47, true
+	case c48:
+		return Size500s.C48, true
+	case c49:
+		return Size500s.C49, true
+	case c50:
+		return Size500s.C50, true
+	case c51:
+		return Size500s.C51, true
+	case c52:
+		return Size500s.C52, true
+	case c53:
+		return Size500s.C53, true
+	case c54:
+		return Size500s.C54, true
+	case c55:
+		return Size500s.C55, true
+	case c56:
+		return Size500s.C56, true
+	case c57:
+		return Size500s.C57, true
+	case c58:
+		return Size500s.C58, true
+	case c59:
+		return Size500s.C59, true
+	case c60:
+		return Size500s.C60, true
+	case c61:
+		return Size500s.C61, true
+	case c62:
+		return Size500s.C62, true
+	case c63:
+		return Size500s.C63, true
+	case c64:
+		return Size500s.C64, true
+	case c65:
+		return Size500s.C65, true
+	case c66:
+		return Size500s.C66, true
+	case c67:
+		return Size500s.C67, true
+	case c68:
+		return Size500s.C68, true
+	case c69:
+		return Size500s.C69, true
+	case c70:
+		return Size500s.C70, true
+	case c71:
+		return Size500s.C71, true
+	case c72:
+		return Size500s.C72, true
+	case c73:
+		return Size500s.C73, true
+	case c74:
+		return Size500s.C74, true
+	case c75:
+		return Size500s.C75, true
+	case c76:
+		return Size500s.C76, true
+	case c77:
+		return Size500s.C77, true
+	case c78:
+		return Size500s.C78, true
+	case c79:
+		return Size500s.C79, true
+	case c80:
+		return Size500s.C80, true
+	case c81:
+		return Size500s.C81, true
+	case c82:
+		return Size500s.C82, true
+	case c83:
+		return Size500s.C83, true
+	case c84:
+		return Size500s.C84, true
+	case c85:
+		return Size500s.C85, true
+	case c86:
+		return Size500s.C86, true
+	case c87:
+		return Size500s.C87, true
+	case c88:
+		return Size500s.C88, true
+	case c89:
+		return Size500s.C89, true
+	case c90:
+		return Size500s.C90, true
+	case c91:
+		return Size500s.C91, true
+	case c92:
+		return Size500s.C92, true
+	case c93:
+		return Size500s.C93, true
+	case c94:
+		return Size500s.C94, true
+	case c95:
+		return Size500s.C95, true
+	case c96:
+		return Size500s.C96, true
+	case c97:
+		return Size500s.C97, true
+	case c98:
+		return Size500s.C98, true
+	case c99:
+		return Size500s.C99, true
+	case c100:
+		return Size500s.C100, true
+	case c101:
+		return Size500s.C101, true
+	case c102:
+		return Size500s.C102, true
+	case c103:
+		return Size500s.C103, true
+	case c104:
+		return Size500s.C104, true
+	case c105:
+		return Size500s.C105, true
+	case c106:
+		return Size500s.C106, true
+	case c107:
+		return Size500s.C107, true
+	case c108:
+		return Size500s.C108, true
+	case c109:
+		return Size500s.C109, true
+	case c110:
+		return Size500s.C110, true
+	case c111:
+		return Size500s.C111, true
+	case c112:
+		return Size500s.C112, true
+	case c113:
+		return Size500s.C113, true
+	case c114:
+		return Size500s.C114, true
+	case c115:
+		return Size500s.C115, true
+	case c116:
+		return Size500s.C116, true
+	case c117:
+		return Size500s.C117, true
+	case c118:
+		return Size500s.C118, true
+	case c119:
+		return Size500s.C119, true
+	case c120:
+		return Size500s.C120, true
+	case c121:
+		return Size500s.C121, true
+	case c122:
+		return Size500s.C122, true
+	case c123:
+		return Size500s.C123, true
+	case c124:
+		return Size500s.C124, true
+	case c125:
+		return Size500s.C125, true
+	case c126:
+		return Size500s.C126, true
+	case c127:
+		return Size500s.C127, true
+	case c128:
+		return Size500s.C128, true
+	case c129:
+		return Size500s.C129, true
+	case c130:
+		return Size500s.C130, true
+	case c131:
+		return Size500s.C131, true
+	case c132:
+		return Size500s.C132, true
+	case c133:
+		return Size500s.C133, true
+	case c134:
+		return Size500s.C134, true
+	case c135:
+		return Size500s.C135, true
+	case c136:
+		return Size500s.C136, true
+	case c137:
+		return Size500s.C137, true
+	case c138:
+		return Size500s.C138, true
+	case c139:
+		return Size500s.C139, true
+	case c140:
+		return Size500s.C140, true
+	case c141:
+		return Size500s.C141, true
+	case c142:
+		return Size500s.C142, true
+	case c143:
+		return Size500s.C143, true
+	case c144:
+		return Size500s.C144, true
+	case c145:
+		return Size500s.C145, true
+	case c146:
+		return Size500s.C146, true
+	case c147:
+		return Size500s.C147, true
+	case c148:
+		return Size500s.C148, true
+	case c149:
+		return Size500s.C149, true
+	case c150:
+		return Size500s.C150, true
+	case c151:
+		return Size500s.C151, true
+	case c152:
+		return Size500s.C152, true
+	case c153:
+		return Size500s.C153, true
+	case c154:
+		return Size500s.C154, true
+	case c155:
+		return Size500s.C155, true
+	case c156:
+		return Size500s.C156, true
+	case c157:
+		return Size500s.C157, true
+	case c158:
+		return Size500s.C158, true
+	case c159:
+		return Size500s.C159, true
+	case c160:
+		return Size500s.C160, true
+	case c161:
+		return Size500s.C161, true
+	case c162:
+		return Size500s.C162, true
+	case c163:
+		return Size500s.C163, true
+	case c164:
+		return Size500s.C164, true
+	case c165:
+		return Size500s.C165, true
+	case c166:
+		return Size500s.C166, true
+	case c167:
+		return Size500s.C167, true
+	case c168:
+		return Size500s.C168, true
+	case c169:
+		return Size500s.C169, true
+	case c170:
+		return Size500s.C170, true
+	case c171:
+		return Size500s.C171, true
+	case c172:
+		return Size500s.C172, true
+	case c173:
+		return Size500s.C173, true
+	case c174:
+		return Size500s.C174, true
+	case c175:
+		return Size500s.C175, true
+	case c176:
+		return Size500s.C176, true
+	case c177:
+		return Size500s.C177, true
+	case c178:
+		return Size500s.C178, true
+	case c179:
+		return Size500s.C179, true
+	case c180:
+		return Size500s.C180, true
+	case c181:
+		return Size500s.C181, true
+	case c182:
+		return Size500s.C182, true
+	case c183:
+		return Size500s.C183, true
+	case c184:
+		return Size500s.C184, true
+	case c185:
+		return Size500s.C185, true
+	case c186:
+		return Size500s.C186, true
+	case c187:
+		return Size500s.C187, true
+	case c188:
+		return Size500s.C188, true
+	case c189:
+		return Size500s.C189, true
+	case c190:
+		return Size500s.C190, true
+	case c191:
+		return Size500s.C191, true
+	case c192:
+		return Size500s.C192, true
+	case c193:
+		return Size500s.C193, true
+	case c194:
+		return Size500s.C194, true
+	case c195:
+		return Size500s.C195, true
+	case c196:
+		return Size500s.C196, true
+	case c197:
+		return Size500s.C197, true
+	case c198:
+		return Size500s.C198, true
+	case c199:
+		return Size500s.C199, true
+	case c200:
+		return Size500s.C200, true
+	case c201:
+		return Size500s.C201, true
+	case c202:
+		return Size500s.C202, true
+	case c203:
+		return Size500s.C203, true
+	case c204:
+		return Size500s.C204, true
+	case c205:
+		return Size500s.C205, true
+	case c206:
+		return Size500s.C206, true
+	case c207:
+		return Size500s.C207, true
+	case c208:
+		return Size500s.C208, true
+	case c209:
+		return Size500s.C209, true
+	case c210:
+		return Size500s.C210, true
+	case c211:
+		return Size500s.C211, true
+	case c212:
+		return Size500s.C212, true
+	case c213:
+		return Size500s.C213, true
+	case c214:
+		return Size500s.C214, true
+	case c215:
+		return Size500s.C215, true
+	case c216:
+		return Size500s.C216, true
+	case c217:
+		return Size500s.C217, true
+	case c218:
+		return Size500s.C218, true
+	case c219:
+		return Size500s.C219, true
+	case c220:
+		return Size500s.C220, true
+	case c221:
+		return Size500s.C221, true
+	case c222:
+		return Size500s.C222, true
+	case c223:
+		return Size500s.C223, true
+	case c224:
+		return Size500s.C224, true
+	case c225:
+		return Size500s.C225, true
+	case c226:
+		return Size500s.C226, true
+	case c227:
+		return Size500s.C227, true
+	case c228:
+		return Size500s.C228, true
+	case c229:
+		return Size500s.C229, true
+	case c230:
+		return Size500s.C230, true
+	case c231:
+		return Size500s.C231, true
+	case c232:
+		return Size500s.C232, true
+	case c233:
+		return Size500s.C233, true
+	case c234:
+		return Size500s.C234, true
+	case c235:
+		return Size500s.C235, true
+	case c236:
+		return Size500s.C236, true
+	case c237:
+		return Size500s.C237, true
+	case c238:
+		return Size500s.C238, true
+	case c239:
+		return Size500s.C239, true
+	case c240:
+		return Size500s.C240, true
+	case c241:
+		return Size500s.C241, true
+	case c242:
+		return Size500s.C242, true
+	case c243:
+		return Size500s.C243, true
+	case c244:
+		return Size500s.C244, true
+	case c245:
+		return Size500s.C245, true
+	case c246:
+		return Size500s.C246, true
+	case c247:
+		return Size500s.C247, true
+	case c248:
+		return Size500s.C248, true
+	case c249:
+		return Size500s.C249, true
+	case c250:
+		return Size500s.C250, true
+	case c251:
+		return Size500s.C251, true
+	case c252:
+		return Size500s.C252, true
+	case c253:
+		return Size500s.C253, true
+	case c254:
+		return Size500s.C254, true
+	case c255:
+		return Size500s.C255, true
+	case c256:
+		return Size500s.C256, true
+	case c257:
+		return Size500s.C257, true
+	case c258:
+		return Size500s.C258, true
+	case c259:
+		return Size500s.C259, true
+	case c260:
+		return Size500s.C260, true
+	case c261:
+		return Size500s.C261, true
+	case c262:
+		return Size500s.C262, true
+	case c263:
+		return Size500s.C263, true
+	case c264:
+		return Size500s.C264, true
+	case c265:
+		return Size500s.C265, true
+	case c266:
+		return Size500s.C266, true
+	case c267:
+		return Size500s.C267, true
+	case c268:
+		return Size500s.C268, true
+	case c269:
+		return Size500s.C269, true
+	case c270:
+		return Size500s.C270, true
+	case c271:
+		return Size500s.C271, true
+	case c272:
+		return Size500s.C272, true
+	case c273:
+		return Size500s.C273, true
+	case c274:
+		return Size500s.C274, true
+	case c275:
+		return Size500s.C275, true
+	case c276:
+		return Size500s.C276, true
+	case c277:
+		return Size500s.C277, true
+	case c278:
+		return Size500s.C278, true
+	case c279:
+		return Size500s.C279, true
+	case c280:
+		return Size500s.C280, true
+	case c281:
+		return Size500s.C281, true
+	case c282:
+		return Size500s.C282, true
+	case c283:
+		return Size500s.C283, true
+	case c284:
+		return Size500s.C284, true
+	case c285:
+		return Size500s.C285, true
+	case c286:
+		return Size500s.C286, true
+	case c287:
+		return Size500s.C287, true
+	case c288:
+		return Size500s.C288, true
+	case c289:
+		return Size500s.C289, true
+	case c290:
+		return Size500s.C290, true
+	case c291:
+		return Size500s.C291, true
+	case c292:
+		return Size500s.C292, true
+	case c293:
+		return Size500s.C293, true
+	case c294:
+		return Size500s.C294, true
+	case c295:
+		return Size500s.C295, true
+	case c296:
+		return Size500s.C296, true
+	case c297:
+		return Size500s.C297, true
+	case c298:
+		return Size500s.C298, true
+	case c299:
+		return Size500s.C299, true
+	case c300:
+		return Size500s.C300, true
+	case c301:
+		return Size500s.C301, true
+	case c302:
+		return Size500s.C302, true
+	case c303:
+		return Size500s.C303, true
+	case c304:
+		return Size500s.C304, true
+	case c305:
+		return Size500s.C305, true
+	case c306:
+		return Size500s.C306, true
+	case c307:
+		return Size500s.C307, true
+	case c308:
+		return Size500s.C308, true
+	case c309:
+		return Size500s.C309, true
+	case c310:
+		return Size500s.C310, true
+	case c311:
+		return Size500s.C311, true
+	case c312:
+		return Size500s.C312, true
+	case c313:
+		return Size500s.C313, true
+	case c314:
+		return Size500s.C314, true
+	case c315:
+		return Size500s.C315, true
+	case c316:
+		return Size500s.C316, true
+	case c317:
+		return Size500s.C317, true
+	case c318:
+		return Size500s.C318, true
+	case c319:
+		return Size500s.C319, true
+	case c320:
+		return Size500s.C320, true
+	case c321:
+		return Size500s.C321, true
+	case c322:
+		return Size500s.C322, true
+	case c323:
+		return Size500s.C323, true
+	case c324:
+		return Size500s.C324, true
+	case c325:
+		return Size500s.C325, true
+	case c326:
+		return Size500s.C326, true
+	case c327:
+		return Size500s.C327, true
+	case c328:
+		return Size500s.C328, true
+	case c329:
+		return Size500s.C329, true
+	case c330:
+		return Size500s.C330, true
+	case c331:
+		return Size500s.C331, true
+	case c332:
+		return Size500s.C332, true
+	case c333:
+		return Size500s.C333, true
+	case c334:
+		return Size500s.C334, true
+	case c335:
+		return Size500s.C335, true
+	case c336:
+		return Size500s.C336, true
+	case c337:
+		return Size500s.C337, true
+	case c338:
+		return Size500s.C338, true
+	case c339:
+		return Size500s.C339, true
+	case c340:
+		return Size500s.C340, true
+	case c341:
+		return Size500s.C341, true
+	case c342:
+		return Size500s.C342, true
+	case c343:
+		return Size500s.C343, true
+	case c344:
+		return Size500s.C344, true
+	case c345:
+		return Size500s.C345, true
+	case c346:
+		return Size500s.C346, true
+	case c347:
+		return Size500s.C347, true
+	case c348:
+		return Size500s.C348, true
+	case c349:
+		return Size500s.C349, true
+	case c350:
+		return Size500s.C350, true
+	case c351:
+		return Size500s.C351, true
+	case c352:
+		return Size500s.C352, true
+	case c353:
+		return Size500s.C353, true
+	case c354:
+		return Size500s.C354, true
+	case c355:
+		return Size500s.C355, true
+	case c356:
+		return Size500s.C356, true
+	case c357:
+		return Size500s.C357, true
+	case c358:
+		return Size500s.C358, true
+	case c359:
+		return Size500s.C359, true
+	case c360:
+		return Size500s.C360, true
+	case c361:
+		return Size500s.C361, true
+	case c362:
+		return Size500s.C362, true
+	case c363:
+		return Size500s.C363, true
+	case c364:
+		return Size500s.C364, true
+	case c365:
+		return Size500s.C365, true
+	case c366:
+		return Size500s.C366, true
+	case c367:
+		return Size500s.C367, true
+	case c368:
+		return Size500s.C368, true
+	case c369:
+		return Size500s.C369, true
+	case c370:
+		return Size500s.C370, true
+	case c371:
+		return Size500s.C371, true
+	case c372:
+		return Size500s.C372, true
+	case c373:
+		return Size500s.C373, true
+	case c374:
+		return Size500s.C374, true
+	case c375:
+		return Size500s.C375, true
+	case c376:
+		return Size500s.C376, true
+	case c377:
+		return Size500s.C377, true
+	case c378:
+		return Size500s.C378, true
+	case c379:
+		return Size500s.C379, true
+	case c380:
+		return Size500s.C380, true
+	case c381:
+		return Size500s.C381, true
+	case c382:
+		return Size500s.C382, true
+	case c383:
+		return Size500s.C383, true
+	case c384:
+		return Size500s.C384, true
+	case c385:
+		return Size500s.C385, true
+	case c386:
+		return Size500s.C386, true
+	case c387:
+		return Size500s.C387, true
+	case c388:
+		return Size500s.C388, true
+	case c389:
+		return Size500s.C389, true
+	case c390:
+		return Size500s.C390, true
+	case c391:
+		return Size500s.C391, true
+	case c392:
+		return Size500s.C392, true
+	case c393:
+		return Size500s.C393, true
+	case c394:
+		return Size500s.C394, true
+	case c395:
+		return Size500s.C395, true
+	case c396:
+		return Size500s.C396, true
+	case c397:
+		return Size500s.C397, true
+	case c398:
+		return Size500s.C398, true
+	case c399:
+		return Size500s.C399, true
+	case c400:
+		return Size500s.C400, true
+	case c401:
+		return Size500s.C401, true
+	case c402:
+		return Size500s.C402, true
+	case c403:
+		return Size500s.C403, true
+	case c404:
+		return Size500s.C404, true
+	case c405:
+		return Size500s.C405, true
+	case c406:
+		return Size500s.C406, true
+	case c407:
+		return Size500s.C407, true
+	case c408:
+		return Size500s.C408, true
+	case c409:
+		return Size500s.C409, true
+	case c410:
+		return Size500s.C410, true
+	case c411:
+		return Size500s.C411, true
+	case c412:
+		return Size500s.C412, true
+	case c413:
+		return Size500s.C413, true
+	case c414:
+		return Size500s.C414, true
+	case c415:
+		return Size500s.C415, true
+	case c416:
+		return Size500s.C416, true
+	case c417:
+		return Size500s.C417, true
+	case c418:
+		return Size500s.C418, true
+	case c419:
+		return Size500s.C419, true
+	case c420:
+		return Size500s.C420, true
+	case c421:
+		return Size500s.C421, true
+	case c422:
+		return Size500s.C422, true
+	case c423:
+		return Size500s.C423, true
+	case c424:
+		return Size500s.C424, true
+	case c425:
+		return Size500s.C425, true
+	case c426:
+		return Size500s.C426, true
+	case c427:
+		return Size500s.C427, true
+	case c428:
+		return Size500s.C428, true
+	case c429:
+		return Size500s.C429, true
+	case c430:
+		return Size500s.C430, true
+	case c431:
+		return Size500s.C431, true
+	case c432:
+		return Size500s.C432, true
+	case c433:
+		return Size500s.C433, true
+	case c434:
+		return Size500s.C434, true
+	case c435:
+		return Size500s.C435, true
+	case c436:
+		return Size500s.C436, true
+	case c437:
+		return Size500s.C437, true
+	case c438:
+		return Size500s.C438, true
+	case c439:
+		return Size500s.C439, true
+	case c440:
+		return Size500s.C440, true
+	case c441:
+		return Size500s.C441, true
+	case c442:
+		return Size500s.C442, true
+	case c443:
+		return Size500s.C443, true
+	case c444:
+		return Size500s.C444, true
+	case c445:
+		return Size500s.C445, true
+	case c446:
+		return Size500s.C446, true
+	case c447:
+		return Size500s.C447, true
+	case c448:
+		return Size500s.C448, true
+	case c449:
+		return Size500s.C449, true
+	case c450:
+		return Size500s.C450, true
+	case c451:
+		return Size500s.C451, true
+	case c452:
+		return Size500s.C452, true
+	case c453:
+		return Size500s.C453, true
+	case c454:
+		return Size500s.C454, true
+	case c455:
+		return Size500s.C455, true
+	case c456:
+		return Size500s.C456, true
+	case c457:
+		return Size500s.C457, true
+	case c458:
+		return Size500s.C458, true
+	case c459:
+		return Size500s.C459, true
+	case c460:
+		return Size500s.C460, true
+	case c461:
+		return Size500s.C461, true
+	case c462:
+		return Size500s.C462, true
+	case c463:
+		return Size500s.C463, true
+	case c464:
+		return Size500s.C464, true
+	case c465:
+		return Size500s.C465, true
+	case c466:
+		return Size500s.C466, true
+	case c467:
+		return Size500s.C467, true
+	case c468:
+		return Size500s.C468, true
+	case c469:
+		return Size500s.C469, true
+	case c470:
+		return Size500s.C470, true
+	case c471:
+		return Size500s.C471, true
+	case c472:
+		return Size500s.C472, true
+	case c473:
+		return Size500s.C473, true
+	case c474:
+		return Size500s.C474, true
+	case c475:
+		return Size500s.C475, true
+	case c476:
+		return Size500s.C476, true
+	case c477:
+		return Size500s.C477, true
+	case c478:
+		return Size500s.C478, true
+	case c479:
+		return Size500s.C479, true
+	case c480:
+		return Size500s.C480, true
+	case c481:
+		return Size500s.C481, true
+	case c482:
+		return Size500s.C482, true
+	case c483:
+		return Size500s.C483, true
+	case c484:
+		return Size500s.C484, true
+	case c485:
+		return Size500s.C485, true
+	case c486:
+		return Size500s.C486, true
+	case c487:
+		return Size500s.C487, true
+	case c488:
+		return Size500s.C488, true
+	case c489:
+		return Size500s.C489, true
+	case c490:
+		return Size500s.C490, true
+	case c491:
+		return Size500s.C491, true
+	case c492:
+		return Size500s.C492, true
+	case c493:
+		return Size500s.C493, true
+	case c494:
+		return Size500s.C494, true
+	case c495:
+		return Size500s.C495, true
+	case c496:
+		return Size500s.C496, true
+	case c497:
+		return Size500s.C497, true
+	case c498:
+		return Size500s.C498, true
+	case c499:
+		return Size500s.C499, true
+	}
+	var zero Size500
+	return zero, false
+}
+
+// All container methods for convenience
+func (t size500Container) All() iter.Seq[Size500] {
+	return Size500{}.All()
+}
+
+func (t size500Container) FromName(name string) (Size500, bool) {
+	return Size500{}.FromName(name)
+}
+
+func (t size500Container) FromValue(value int) (Size500, bool) {
+	return Size500{}.FromValue(value)
+}
+
+// MarshalJSON implements the json.Marshaler interface for Size500.
+func (t Size500) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(t.size500), 10), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Size500.
+func (t *Size500) UnmarshalJSON(data []byte) error {
+	// Known values in canonical form are decoded without enums.UnmarshalJSON
+	if v, err := strconv.ParseInt(string(data), 10, 64); err == nil {
+		var buf [20]byte
+		if string(strconv.AppendInt(buf[:0], v, 10)) == string(data) {
+			if result, ok := t.FromValue(int(v)); ok {
+				*t = result
+				return nil
+			}
+		}
+	}
+	result, err := enums.UnmarshalJSON(*t, data)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
@@ -0,0 +1,125 @@
+package benchmarks
+
+import (
+	"iter"
+	"strconv"
+	"testing"
+
+	"github.com/donutnomad/goenum/enums"
+)
+
+// legacyFromName is the FromName lookup emitted by earlier versions of
+// goenum: it ranges over the names map on every call.
+func legacyFromName[R comparable, E enums.Element[R, E]](namesMap map[E][]string, name string) (E, bool) {
+	for enumValue, names := range namesMap {
+		for _, n := range names {
+			if n == name {
+				return enumValue, enumValue.IsValid()
+			}
+		}
+	}
+	var zero E
+	return zero, false
+}
+
+// legacyFromValue is the FromValue lookup emitted by earlier versions of
+// goenum: it walks All() on every call.
+func legacyFromValue[R comparable, E enums.Element[R, E]](all iter.Seq[E], value R) (E, bool) {
+	for v := range all {
+		if v.Val() == value {
+			return v, true
+		}
+	}
+	var zero E
+	return zero, false
+}
+
+type lookupCase[E enums.Element[int, E]] struct {
+	size     int
+	namesMap map[E][]string
+}
+
+func (c lookupCase[E]) names() []string {
+	var e E
+	var names []string
+	for v := range e.All() {
+		names = append(names, v.Name())
+	}
+	return names
+}
+
+func (c lookupCase[E]) values() []int {
+	var e E
+	var values []int
+	for v := range e.All() {
+		values = append(values, v.Val())
+	}
+	return values
+}
+
+func (c lookupCase[E]) benchmarkFromName(b *testing.B) {
+	var e E
+	names := c.names()
+	b.Run(strconv.Itoa(c.size)+"/legacy", func(b *testing.B) {
+		for i := 0; b.Loop(); i++ {
+			if _, ok := legacyFromName[int](c.namesMap, names[i%len(names)]); !ok {
+				b.Fatal("not found")
+			}
+		}
+	})
+	b.Run(strconv.Itoa(c.size)+"/generated", func(b *testing.B) {
+		for i := 0; b.Loop(); i++ {
+			if _, ok := e.FromName(names[i%len(names)]); !ok {
+				b.Fatal("not found")
+			}
+		}
+	})
+}
+
+func (c lookupCase[E]) benchmarkFromValue(b *testing.B) {
+	var e E
+	values := c.values()
+	b.Run(strconv.Itoa(c.size)+"/legacy", func(b *testing.B) {
+		for i := 0; b.Loop(); i++ {
+			if _, ok := legacyFromValue(e.All(), values[i%len(values)]); !ok {
+				b.Fatal("not found")
+			}
+		}
+	})
+	b.Run(strconv.Itoa(c.size)+"/generated", func(b *testing.B) {
+		for i := 0; b.Loop(); i++ {
+			if _, ok := e.FromValue(values[i%len(values)]); !ok {
+				b.Fatal("not found")
+			}
+		}
+	})
+}
+
+func TestLookupsMatchLegacy(t *testing.T) {
+	for _, name := range (lookupCase[Size500]{}).names() {
+		got, gotOK := Size500{}.FromName(name)
+		want, wantOK := legacyFromName[int](size500NamesMap, name)
+		if got != want || gotOK != wantOK {
+			t.Errorf("FromName(%q) = %v, %v; legacy %v, %v", name, got, gotOK, want, wantOK)
+		}
+	}
+	for _, value := range append((lookupCase[Size500]{}).values(), -1, 500) {
+		got, gotOK := Size500{}.FromValue(value)
+		want, wantOK := legacyFromValue(Size500{}.All(), value)
+		if got != want || gotOK != wantOK {
+			t.Errorf("FromValue(%d) = %v, %v; legacy %v, %v", value, got, gotOK, want, wantOK)
+		}
+	}
+}
+
+func BenchmarkFromName(b *testing.B) {
+	lookupCase[Size5]{5, size5NamesMap}.benchmarkFromName(b)
+	lookupCase[Size50]{50, size50NamesMap}.benchmarkFromName(b)
+	lookupCase[Size500]{500, size500NamesMap}.benchmarkFromName(b)
+}
+
+func BenchmarkFromValue(b *testing.B) {
+	lookupCase[Size5]{5, size5NamesMap}.benchmarkFromValue(b)
+	lookupCase[Size50]{50, size50NamesMap}.benchmarkFromValue(b)
+	lookupCase[Size500]{500, size500NamesMap}.benchmarkFromValue(b)
+}
@@ -0,0 +1,200 @@
+package benchmarks
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/xml"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/donutnomad/goenum/enums"
+)
+
+// serdeEnum is an enum generated with -json -text -sql -binary -xml
+// -msgpack -cbor.
+type serdeEnum[R comparable, E comparable] interface {
+	comparable
+	enums.Enum[R, E]
+	MarshalJSON() ([]byte, error)
+	MarshalText() ([]byte, error)
+	Value() (driver.Value, error)
+	MarshalBinary() ([]byte, error)
+	MarshalXMLAttr(xml.Name) (xml.Attr, error)
+	MarshalMsgpack() ([]byte, error)
+	MarshalCBOR() ([]byte, error)
+}
+
+// serdeCase benchmarks the specialized methods generated for an enum
+// against the generic, reflection based helpers they replace.
+type serdeCase[R comparable, E serdeEnum[R, E], P interface {
+	*E
+	UnmarshalJSON([]byte) error
+	UnmarshalText([]byte) error
+	Scan(any) error
+	UnmarshalXMLAttr(xml.Attr) error
+}] struct {
+	name string
+}
+
+func (c serdeCase[R, E, P]) values() []E {
+	var e E
+	return slices.Collect(e.All())
+}
+
+// encoders returns the generated and the generic encoders of v.
+func (c serdeCase[R, E, P]) encoders(v E) []struct {
+	name               string
+	generated, generic func() ([]byte, error)
+} {
+	attr := func(a xml.Attr, err error) ([]byte, error) { return []byte(a.Value), err }
+	name := xml.Name{Local: "v"}
+	return []struct {
+		name               string
+		generated, generic func() ([]byte, error)
+	}{
+		{"MarshalJSON", v.MarshalJSON, func() ([]byte, error) { return enums.MarshalJSON(v, v.Val()) }},
+		{"MarshalText", v.MarshalText, func() ([]byte, error) { return enums.MarshalText(v, v.Val()) }},
+		{"MarshalBinary", v.MarshalBinary, func() ([]byte, error) { return enums.MarshalBinary(v, v.Val()) }},
+		{"MarshalXMLAttr", func() ([]byte, error) { return attr(v.MarshalXMLAttr(name)) },
+			func() ([]byte, error) { return attr(enums.MarshalXMLAttr(v, v.Val(), name)) }},
+		{"MarshalMsgpack", v.MarshalMsgpack, func() ([]byte, error) { return enums.MarshalMsgpack(v, v.Val()) }},
+		{"MarshalCBOR", v.MarshalCBOR, func() ([]byte, error) { return enums.MarshalCBOR(v, v.Val()) }},
+	}
+}
+
+// decoders returns the generated decoders of the encoded forms of v, which
+// decode into decoded, and the generic decoders.
+func (c serdeCase[R, E, P]) decoders(v E, decoded P) []struct {
+	name      string
+	generated func() error
+	generic   func() (*E, error)
+} {
+	var zero E
+	json, _ := v.MarshalJSON()
+	text, _ := v.MarshalText()
+	value, _ := v.Value()
+	attr := xml.Attr{Value: string(text)}
+	return []struct {
+		name      string
+		generated func() error
+		generic   func() (*E, error)
+	}{
+		{"UnmarshalJSON", func() error { return decoded.UnmarshalJSON(json) },
+			func() (*E, error) { return enums.UnmarshalJSON(zero, json) }},
+		{"UnmarshalText", func() error { return decoded.UnmarshalText(text) },
+			func() (*E, error) { return enums.UnmarshalText(zero, text) }},
+		{"UnmarshalXMLAttr", func() error { return decoded.UnmarshalXMLAttr(attr) },
+			func() (*E, error) { return enums.UnmarshalXMLAttr(zero, attr) }},
+		{"Scan", func() error { return decoded.Scan(value) },
+			func() (*E, error) { return enums.SQLScan(zero, value) }},
+	}
+}
+
+func (c serdeCase[R, E, P]) check(t *testing.T) {
+	for _, v := range c.values() {
+		for _, enc := range c.encoders(v) {
+			got, err := enc.generated()
+			want, _ := enc.generic()
+			if err != nil || !bytes.Equal(got, want) {
+				t.Errorf("%v.%s() = %q, %v; generic %q", v, enc.name, got, err, want)
+			}
+		}
+		decoded := new(E)
+		for _, dec := range c.decoders(v, decoded) {
+			*decoded = *new(E)
+			if err := dec.generated(); err != nil || *decoded != v {
+				t.Errorf("%s of %v = %v, %v", dec.name, v, *decoded, err)
+			}
+		}
+		gotValue, _ := v.Value()
+		wantValue, _ := enums.SQLValue(v)
+		if gotValue != wantValue {
+			t.Errorf("%v.Value() = %v; generic %v", v, gotValue, wantValue)
+		}
+	}
+}
+
+func (c serdeCase[R, E, P]) benchmark(b *testing.B) {
+	v := c.values()[0]
+	for _, enc := range c.encoders(v) {
+		b.Run(c.name+"/"+enc.name+"/generic", func(b *testing.B) {
+			for b.Loop() {
+				if _, err := enc.generic(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(c.name+"/"+enc.name+"/generated", func(b *testing.B) {
+			for b.Loop() {
+				if _, err := enc.generated(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+	for _, dec := range c.decoders(v, new(E)) {
+		b.Run(c.name+"/"+dec.name+"/generic", func(b *testing.B) {
+			for b.Loop() {
+				if _, err := dec.generic(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(c.name+"/"+dec.name+"/generated", func(b *testing.B) {
+			for b.Loop() {
+				if err := dec.generated(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+	b.Run(c.name+"/Value/generic", func(b *testing.B) {
+		for b.Loop() {
+			if _, err := enums.SQLValue(v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run(c.name+"/Value/generated", func(b *testing.B) {
+		for b.Loop() {
+			if _, err := v.Value(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+var (
+	priorityCase = serdeCase[int, Priority, *Priority]{"int"}
+	channelCase  = serdeCase[string, Channel, *Channel]{"string"}
+)
+
+func TestSerdeMatchesGeneric(t *testing.T) {
+	priorityCase.check(t)
+	channelCase.check(t)
+}
+
+func TestXMLErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		want  error
+	}{
+		{"<p> 2 </p>", nil},
+		{"<p>9</p>", enums.ErrUnknownValue},
+		{"<p>x</p>", enums.ErrInvalidValue},
+		{"<p>1</q>", enums.ErrInvalidValue},
+	}
+	for _, tt := range tests {
+		var p Priority
+		err := xml.Unmarshal([]byte(tt.input), &p)
+		if tt.want == nil && (err != nil || p != Priorities.Medium) || tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("xml.Unmarshal(%s) = %v, %v, want %v", tt.input, p, err, tt.want)
+		}
+	}
+}
+
+func BenchmarkSerde(b *testing.B) {
+	priorityCase.benchmark(b)
+	channelCase.benchmark(b)
+}
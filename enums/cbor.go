@@ -0,0 +1,174 @@
+package enums
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// MarshalCBOR encodes e as CBOR: a text string with FormatName, and the
+// value with FormatValue, using the shortest integer encoding that holds it.
+// b is the raw value of e, see MarshalJSON.
+func MarshalCBOR[R comparable, T comparable, E Enum[R, T]](e E, b any) ([]byte, error) {
+	if e.SerdeFormat() == FormatName {
+		return append(appendCBORHead(nil, cborText, uint64(len(e.Name()))), e.Name()...), nil
+	}
+	value, err := scalarOf(b)
+	if err != nil {
+		return nil, err
+	}
+	return appendCBORScalar(nil, value), nil
+}
+
+// AppendCBOR appends the CBOR encoding of e to dst, the same bytes
+// MarshalCBOR returns, without reflection for the basic base types.
+func AppendCBOR[R comparable, T comparable, E Enum[R, T]](e E, dst []byte) ([]byte, error) {
+	if e.SerdeFormat() == FormatName {
+		return append(appendCBORHead(dst, cborText, uint64(len(e.Name()))), e.Name()...), nil
+	}
+	value, err := scalarOf(e.Val())
+	if err != nil {
+		return nil, err
+	}
+	return appendCBORScalar(dst, value), nil
+}
+
+// appendCBORScalar appends a scalar returned by scalarOf.
+func appendCBORScalar(b []byte, v scalar) []byte {
+	switch v.kind {
+	case reflect.Int64:
+		if v.i < 0 {
+			return appendCBORHead(b, cborNegative, uint64(-1-v.i))
+		}
+		return appendCBORHead(b, cborUnsigned, uint64(v.i))
+	case reflect.Uint64:
+		return appendCBORHead(b, cborUnsigned, v.u)
+	case reflect.Float32:
+		return binary.BigEndian.AppendUint32(append(b, 0xfa), math.Float32bits(float32(v.f)))
+	case reflect.Float64:
+		return binary.BigEndian.AppendUint64(append(b, 0xfb), math.Float64bits(v.f))
+	default:
+		return append(appendCBORHead(b, cborText, uint64(len(v.s))), v.s...)
+	}
+}
+
+// UnmarshalCBOR decodes a CBOR text string or number as encoded by
+// MarshalCBOR. Any integer or float encoding is accepted.
+func UnmarshalCBOR[R comparable, T comparable, E Enum[R, T]](e E, data []byte) (*E, error) {
+	value, err := decodeCBOR(data)
+	if err != nil {
+		return nil, invalidValue[E](data, e.SerdeFormat(), err)
+	}
+	return decodeScalar(e, value, data)
+}
+
+// CBOR major types
+const (
+	cborUnsigned = 0
+	cborNegative = 1
+	cborText     = 3
+	cborSimple   = 7
+)
+
+func appendCBORHead(b []byte, major byte, n uint64) []byte {
+	major <<= 5
+	switch {
+	case n < 24:
+		return append(b, major|byte(n))
+	case n <= math.MaxUint8:
+		return append(b, major|24, byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, major|25), uint16(n))
+	case n <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, major|26), uint32(n))
+	default:
+		return binary.BigEndian.AppendUint64(append(b, major|27), n)
+	}
+}
+
+var errCBORShort = errors.New("cbor: unexpected end of data")
+
+// decodeCBOR decodes a single CBOR text string, integer or float into a
+// string, int64, uint64 or float64.
+func decodeCBOR(data []byte) (any, error) {
+	if len(data) == 0 {
+		return nil, errCBORShort
+	}
+	major, info, rest := data[0]>>5, data[0]&0x1f, data[1:]
+	var n uint64
+	switch {
+	case info < 24:
+		n = uint64(info)
+	case info <= 27:
+		size := 1 << (info - 24)
+		if len(rest) < size {
+			return nil, errCBORShort
+		}
+		for _, b := range rest[:size] {
+			n = n<<8 | uint64(b)
+		}
+		rest = rest[size:]
+	default:
+		return nil, fmt.Errorf("cbor: unsupported additional information %d", info)
+	}
+
+	var value any
+	switch major {
+	case cborUnsigned:
+		value = n
+		if n <= math.MaxInt64 {
+			value = int64(n)
+		}
+	case cborNegative:
+		if n > math.MaxInt64 {
+			return nil, errors.New("cbor: negative integer overflows int64")
+		}
+		value = -1 - int64(n)
+	case cborText:
+		if uint64(len(rest)) < n {
+			return nil, errCBORShort
+		}
+		value, rest = string(rest[:n]), rest[n:]
+	case cborSimple:
+		switch info {
+		case 25:
+			value = float16ToFloat64(uint16(n))
+		case 26:
+			value = float64(math.Float32frombits(uint32(n)))
+		case 27:
+			value = math.Float64frombits(n)
+		default:
+			return nil, fmt.Errorf("cbor: unexpected simple value %d", n)
+		}
+	default:
+		return nil, fmt.Errorf("cbor: unexpected major type %d", major)
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("cbor: trailing data")
+	}
+	return value, nil
+}
+
+// float16ToFloat64 converts an IEEE 754 half-precision float.
+func float16ToFloat64(h uint16) float64 {
+	exp := int(h>>10) & 0x1f
+	frac := float64(h & 0x3ff)
+	var f float64
+	switch exp {
+	case 0:
+		f = math.Ldexp(frac, -24)
+	case 0x1f:
+		f = math.Inf(1)
+		if frac != 0 {
+			f = math.NaN()
+		}
+	default:
+		f = math.Ldexp(frac+1024, exp-25)
+	}
+	if h&0x8000 != 0 {
+		f = -f
+	}
+	return f
+}
@@ -0,0 +1,96 @@
+package enums
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// 测试向量来自 RFC 8949 附录 A
+func TestCBORVectors(t *testing.T) {
+	tests := []struct {
+		hex  string
+		want any
+	}{
+		{"00", int64(0)},
+		{"17", int64(23)},
+		{"1818", int64(24)},
+		{"1864", int64(100)},
+		{"1903e8", int64(1000)},
+		{"1a000f4240", int64(1000000)},
+		{"1b000000e8d4a51000", int64(1000000000000)},
+		{"1bffffffffffffffff", uint64(18446744073709551615)},
+		{"20", int64(-1)},
+		{"3863", int64(-100)},
+		{"3903e7", int64(-1000)},
+		{"6161", "a"},
+		{"6449455446", "IETF"},
+		{"f93c00", 1.0},
+		{"f93e00", 1.5},
+		{"f97bff", 65504.0},
+		{"fa47c35000", 100000.0},
+		{"fb3ff199999999999a", 1.1},
+	}
+	for _, tt := range tests {
+		data, _ := hex.DecodeString(tt.hex)
+		got, err := decodeCBOR(data)
+		if err != nil || got != tt.want {
+			t.Errorf("decodeCBOR(%s) = %v, %v, want %v", tt.hex, got, err, tt.want)
+		}
+		// 整数和字符串按最短形式编码
+		var encoded []byte
+		switch v := tt.want.(type) {
+		case int64:
+			if v < 0 {
+				encoded = appendCBORHead(nil, cborNegative, uint64(-1-v))
+			} else {
+				encoded = appendCBORHead(nil, cborUnsigned, uint64(v))
+			}
+		case uint64:
+			encoded = appendCBORHead(nil, cborUnsigned, v)
+		case string:
+			encoded = append(appendCBORHead(nil, cborText, uint64(len(v))), v...)
+		default:
+			continue
+		}
+		if !bytes.Equal(encoded, data) {
+			t.Errorf("encode %v = %x, want %s", tt.want, encoded, tt.hex)
+		}
+	}
+
+	for _, input := range []string{"", "f5", "f6", "7f", "40", "1a0001", "0000", "3bffffffffffffffff"} {
+		data, _ := hex.DecodeString(input)
+		if _, err := decodeCBOR(data); err == nil {
+			t.Errorf("decodeCBOR(%s) should fail", input)
+		}
+	}
+}
+
+func TestCBOREnums(t *testing.T) {
+	data, err := MarshalCBOR(statusDone, statusDone.Val())
+	if err != nil || !bytes.Equal(data, []byte{0x03}) {
+		t.Fatalf("MarshalCBOR(Done) = %x, %v, want 03", data, err)
+	}
+	v, err := UnmarshalCBOR(testStatus{}, []byte{0x18, 0x03})
+	if err != nil || *v != statusDone {
+		t.Errorf("UnmarshalCBOR(1803) = %v, %v, want Done", v, err)
+	}
+
+	green := testColorByName{testColor{"green"}}
+	data, err = MarshalCBOR(green, green.Val())
+	if err != nil || !bytes.Equal(data, []byte("\x65Green")) {
+		t.Fatalf("MarshalCBOR(Green) = %x, %v", data, err)
+	}
+	c, err := UnmarshalCBOR(testColorByName{}, data)
+	if err != nil || *c != green {
+		t.Errorf("UnmarshalCBOR(Green) = %v, %v", c, err)
+	}
+
+	if _, err := UnmarshalCBOR(testStatus{}, []byte{0x09}); !errors.Is(err, ErrUnknownValue) {
+		t.Errorf("unknown value: %v, want ErrUnknownValue", err)
+	}
+	if _, err := UnmarshalCBOR(testStatus{}, []byte("\x65Green")); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("string for value format: %v, want ErrInvalidValue", err)
+	}
+}
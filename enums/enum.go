@@ -22,3 +22,11 @@ type Enum[R comparable, Self comparable] interface {
 	Name() string // Enum name, required value
 	String() string
 }
+
+// FallbackEnum is implemented by enums declared with a fallback value.
+// Decoders return Fallback(input, format) for an input that matches no valid
+// value instead of failing with an *UnknownValueError. input is the decoded
+// name (a string) or value (of the base type), according to format.
+type FallbackEnum[Self any] interface {
+	Fallback(input any, format Format) Self
+}
@@ -0,0 +1,210 @@
+package enums
+
+import (
+	"iter"
+)
+
+// testColor 是用于测试的字符串枚举
+type testColor struct {
+	val string
+}
+
+var testColors = []testColor{{"red"}, {"green"}, {"blue"}}
+
+var testColorNames = map[string]string{"red": "Red", "green": "Green", "blue": "Blue"}
+
+func (c testColor) Val() string { return c.val }
+
+func (c testColor) All() iter.Seq[testColor] {
+	return func(yield func(testColor) bool) {
+		for _, v := range testColors {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (c testColor) IsValid() bool {
+	_, ok := testColorNames[c.val]
+	return ok
+}
+
+func (c testColor) FromName(name string) (testColor, bool) {
+	for _, v := range testColors {
+		if v.Name() == name {
+			return v, true
+		}
+	}
+	return testColor{}, false
+}
+
+func (c testColor) FromValue(value string) (testColor, bool) {
+	for _, v := range testColors {
+		if v.val == value {
+			return v, true
+		}
+	}
+	return testColor{}, false
+}
+
+func (c testColor) SerdeFormat() Format { return FormatValue }
+func (c testColor) Name() string        { return testColorNames[c.val] }
+func (c testColor) String() string      { return c.Name() }
+
+// testColorByName 与 testColor 相同，但按名称序列化
+type testColorByName struct {
+	testColor
+}
+
+func (c testColorByName) All() iter.Seq[testColorByName] {
+	return func(yield func(testColorByName) bool) {
+		for v := range c.testColor.All() {
+			if !yield(testColorByName{v}) {
+				return
+			}
+		}
+	}
+}
+
+func (c testColorByName) FromName(name string) (testColorByName, bool) {
+	v, ok := c.testColor.FromName(name)
+	return testColorByName{v}, ok
+}
+
+func (c testColorByName) FromValue(value string) (testColorByName, bool) {
+	v, ok := c.testColor.FromValue(value)
+	return testColorByName{v}, ok
+}
+
+func (c testColorByName) SerdeFormat() Format { return FormatName }
+
+// testStatus 是用于测试的整数枚举
+type testStatus struct {
+	val int
+}
+
+var testStatuses = []testStatus{{1}, {2}, {3}}
+
+var testStatusNames = map[int]string{1: "Pending", 2: "Active", 3: "Done"}
+
+func (s testStatus) Val() int { return s.val }
+
+func (s testStatus) All() iter.Seq[testStatus] {
+	return func(yield func(testStatus) bool) {
+		for _, v := range testStatuses {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (s testStatus) IsValid() bool {
+	_, ok := testStatusNames[s.val]
+	return ok
+}
+
+func (s testStatus) FromName(name string) (testStatus, bool) {
+	for _, v := range testStatuses {
+		if v.Name() == name {
+			return v, true
+		}
+	}
+	return testStatus{}, false
+}
+
+func (s testStatus) FromValue(value int) (testStatus, bool) {
+	for _, v := range testStatuses {
+		if v.val == value {
+			return v, true
+		}
+	}
+	return testStatus{}, false
+}
+
+func (s testStatus) SerdeFormat() Format { return FormatValue }
+func (s testStatus) Name() string        { return testStatusNames[s.val] }
+func (s testStatus) String() string      { return s.Name() }
+
+// testPerm 是用于测试的位标志枚举
+type testPerm struct {
+	val    uint8
+	format Format
+}
+
+var testPerms = []testPerm{{val: 1}, {val: 2}, {val: 4}}
+
+var testPermNames = map[uint8]string{1: "Read", 2: "Write", 4: "Exec"}
+
+func (p testPerm) Val() uint8 { return p.val }
+
+func (p testPerm) All() iter.Seq[testPerm] {
+	return func(yield func(testPerm) bool) {
+		for _, v := range testPerms {
+			v.format = p.format
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (p testPerm) IsValid() bool {
+	_, ok := testPermNames[p.val]
+	return ok
+}
+
+func (p testPerm) FromName(name string) (testPerm, bool) {
+	for v := range p.All() {
+		if v.Name() == name {
+			return v, true
+		}
+	}
+	return testPerm{}, false
+}
+
+func (p testPerm) FromValue(value uint8) (testPerm, bool) {
+	for v := range p.All() {
+		if v.val == value {
+			return v, true
+		}
+	}
+	return testPerm{}, false
+}
+
+func (p testPerm) SerdeFormat() Format { return p.format }
+func (p testPerm) Name() string        { return testPermNames[p.val] }
+func (p testPerm) String() string      { return p.Name() }
+
+// testOpenStatus 与 testStatus 相同，但未知值解码为保留原值的回退值
+type testOpenStatus struct {
+	testStatus
+}
+
+func (s testOpenStatus) All() iter.Seq[testOpenStatus] {
+	return func(yield func(testOpenStatus) bool) {
+		for v := range s.testStatus.All() {
+			if !yield(testOpenStatus{v}) {
+				return
+			}
+		}
+	}
+}
+
+func (s testOpenStatus) FromName(name string) (testOpenStatus, bool) {
+	v, ok := s.testStatus.FromName(name)
+	return testOpenStatus{v}, ok
+}
+
+func (s testOpenStatus) FromValue(value int) (testOpenStatus, bool) {
+	v, ok := s.testStatus.FromValue(value)
+	return testOpenStatus{v}, ok
+}
+
+func (s testOpenStatus) Fallback(input any, format Format) testOpenStatus {
+	if value, ok := input.(int); ok && format == FormatValue {
+		return testOpenStatus{testStatus{value}}
+	}
+	return testOpenStatus{}
+}
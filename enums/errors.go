@@ -0,0 +1,126 @@
+package enums
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	// ErrUnknownValue is matched by errors for well-formed input that does
+	// not correspond to any valid enum value.
+	ErrUnknownValue = errors.New("unknown enum value")
+	// ErrInvalidValue is matched by errors for input that cannot be decoded
+	// into a name or into the underlying type of the enum.
+	ErrInvalidValue = errors.New("invalid enum value")
+	// ErrInvalidTransition is matched by errors for transitions that the
+	// state machine of an enum does not allow.
+	ErrInvalidTransition = errors.New("invalid transition")
+)
+
+// String returns "name" or "value".
+func (f Format) String() string {
+	switch f {
+	case FormatName:
+		return "name"
+	case FormatValue:
+		return "value"
+	default:
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+}
+
+// UnknownValueError reports a decoded name or value that does not match any
+// valid enum value. It matches ErrUnknownValue with errors.Is.
+type UnknownValueError struct {
+	Type   string // Name of the enum type, e.g. "OrderStatus"
+	Input  any    // The decoded name or value
+	Format Format // Whether Input was looked up as a name or a value
+}
+
+func (e *UnknownValueError) Error() string {
+	return fmt.Sprintf("unknown %s %s %v", e.Type, e.Format, e.Input)
+}
+
+func (e *UnknownValueError) Is(target error) bool {
+	return target == ErrUnknownValue
+}
+
+// InvalidValueError reports input that could not be decoded at all, such as
+// a JSON string for an integer enum or an integer that overflows the
+// underlying type. It matches ErrInvalidValue with errors.Is and unwraps to
+// the decoding error.
+type InvalidValueError struct {
+	Type   string // Name of the enum type, e.g. "OrderStatus"
+	Input  any    // The raw input
+	Format Format // Whether the input was decoded as a name or a value
+	Err    error  // The underlying decoding error
+}
+
+func (e *InvalidValueError) Error() string {
+	return fmt.Sprintf("invalid %s %s %v: %v", e.Type, e.Format, e.Input, e.Err)
+}
+
+func (e *InvalidValueError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+func (e *InvalidValueError) Unwrap() error {
+	return e.Err
+}
+
+// EventError reports an event that cannot be fired in the current state.
+// It matches ErrInvalidTransition with errors.Is.
+type EventError struct {
+	Type  string // Name of the enum type, e.g. "OrderStatus"
+	State string // Name of the current state
+	Event string
+}
+
+func (e *EventError) Error() string {
+	return fmt.Sprintf("%s: event %s is not available in state %s", e.Type, e.Event, e.State)
+}
+
+func (e *EventError) Is(target error) bool {
+	return target == ErrInvalidTransition
+}
+
+// TransitionError reports a transition that the state machine of an enum
+// does not allow or that was vetoed by a guard. It matches
+// ErrInvalidTransition with errors.Is and unwraps to the error of the guard.
+type TransitionError struct {
+	Type string // Name of the enum type, e.g. "OrderStatus"
+	From any    // The state the transition starts from
+	To   any    // The target state
+	Err  error  // The error returned by a guard, nil if the transition is not allowed
+}
+
+func (e *TransitionError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: transition from %v to %v vetoed: %v", e.Type, e.From, e.To, e.Err)
+	}
+	return fmt.Sprintf("%s: invalid transition from %v to %v", e.Type, e.From, e.To)
+}
+
+func (e *TransitionError) Is(target error) bool {
+	return target == ErrInvalidTransition
+}
+
+func (e *TransitionError) Unwrap() error {
+	return e.Err
+}
+
+// typeName returns the name of the enum type E.
+func typeName[E any]() string {
+	return reflect.TypeFor[E]().Name()
+}
+
+// unknownValue returns an *UnknownValueError for the enum type E.
+func unknownValue[E any](input any, format Format) error {
+	return &UnknownValueError{Type: typeName[E](), Input: input, Format: format}
+}
+
+// invalidValue returns an *InvalidValueError for the enum type E.
+func invalidValue[E any](input any, format Format, err error) error {
+	return &InvalidValueError{Type: typeName[E](), Input: input, Format: format, Err: err}
+}
@@ -0,0 +1,171 @@
+package enums
+
+import (
+	"encoding/xml"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// failingYAMLNode 是无法解码的 YAML 节点
+type failingYAMLNode struct{}
+
+func (failingYAMLNode) Decode(any) error { return errors.New("malformed node") }
+
+func TestUnknownValueError(t *testing.T) {
+	tests := []struct {
+		name   string
+		decode func() error
+		input  any
+		format Format
+		typ    string
+	}{
+		{"JSON 名称", func() error {
+			_, err := UnmarshalJSON(testColorByName{}, []byte(`"Purple"`))
+			return err
+		}, "Purple", FormatName, "testColorByName"},
+		{"JSON 值", func() error {
+			_, err := UnmarshalJSON(testStatus{}, []byte(`9`))
+			return err
+		}, 9, FormatValue, "testStatus"},
+		{"Text", func() error {
+			_, err := UnmarshalText(testStatus{}, []byte("9"))
+			return err
+		}, 9, FormatValue, "testStatus"},
+		{"Binary", func() error {
+			_, err := UnmarshalBinary(testColorByName{}, []byte("Purple"))
+			return err
+		}, "Purple", FormatName, "testColorByName"},
+		{"SQL", func() error {
+			_, err := SQLScan(testColor{}, "purple")
+			return err
+		}, "purple", FormatValue, "testColor"},
+		{"YAML", func() error {
+			_, err := UnmarshalYAML(testStatus{}, &MockYAMLNode{value: 9})
+			return err
+		}, 9, FormatValue, "testStatus"},
+		{"XML", func() error {
+			_, err := decodeXMLElement("<s> 9 </s>")
+			return err
+		}, 9, FormatValue, "testStatus"},
+		{"Flags", func() error {
+			_, err := ParseFlags(testPerm{}, "Read|Delete")
+			return err
+		}, "Delete", FormatName, "testPerm"},
+		{"Flags 掩码", func() error {
+			_, err := UnmarshalFlagsJSON(testPerm{format: FormatValue}, []byte(`9`))
+			return err
+		}, uint8(9), FormatValue, "testPerm"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.decode()
+			if !errors.Is(err, ErrUnknownValue) {
+				t.Fatalf("errors.Is(%v, ErrUnknownValue) = false", err)
+			}
+			if errors.Is(err, ErrInvalidValue) {
+				t.Errorf("errors.Is(%v, ErrInvalidValue) = true", err)
+			}
+			var uerr *UnknownValueError
+			if !errors.As(err, &uerr) {
+				t.Fatalf("errors.As(%v, *UnknownValueError) = false", err)
+			}
+			if uerr.Type != tt.typ || uerr.Input != tt.input || uerr.Format != tt.format {
+				t.Errorf("got {%s %v %s}, want {%s %v %s}", uerr.Type, uerr.Input, uerr.Format, tt.typ, tt.input, tt.format)
+			}
+		})
+	}
+}
+
+func TestInvalidValueError(t *testing.T) {
+	tests := []struct {
+		name   string
+		decode func() error
+	}{
+		{"JSON 类型不匹配", func() error {
+			_, err := UnmarshalJSON(testStatus{}, []byte(`"Active"`))
+			return err
+		}},
+		{"Text 非数字", func() error {
+			_, err := UnmarshalText(testStatus{}, []byte("abc"))
+			return err
+		}},
+		{"Binary 数据不足", func() error {
+			_, err := UnmarshalBinary(testStatus{}, []byte{})
+			return err
+		}},
+		{"SQL 溢出", func() error {
+			_, err := SQLScan(testPerm{format: FormatValue}, int64(300))
+			return err
+		}},
+		{"YAML 解码失败", func() error {
+			_, err := UnmarshalYAML(testStatus{}, failingYAMLNode{})
+			return err
+		}},
+		{"XML 元素格式错误", func() error {
+			_, err := decodeXMLElement("<s>1</t>")
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.decode()
+			if !errors.Is(err, ErrInvalidValue) {
+				t.Fatalf("errors.Is(%v, ErrInvalidValue) = false", err)
+			}
+			if errors.Is(err, ErrUnknownValue) {
+				t.Errorf("errors.Is(%v, ErrUnknownValue) = true", err)
+			}
+			var ierr *InvalidValueError
+			if !errors.As(err, &ierr) {
+				t.Fatalf("errors.As(%v, *InvalidValueError) = false", err)
+			}
+			if ierr.Err == nil || errors.Unwrap(err) != ierr.Err {
+				t.Errorf("Unwrap() = %v, want %v", errors.Unwrap(err), ierr.Err)
+			}
+		})
+	}
+
+	var numErr *strconv.NumError
+	_, err := UnmarshalText(testStatus{}, []byte("abc"))
+	if !errors.As(err, &numErr) {
+		t.Errorf("errors.As(%v, *strconv.NumError) = false", err)
+	}
+}
+
+func TestErrorMessages(t *testing.T) {
+	err := &UnknownValueError{Type: "OrderStatus", Input: "Lost", Format: FormatName}
+	if got, want := err.Error(), "unknown OrderStatus name Lost"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	ierr := &InvalidValueError{Type: "OrderStatus", Input: "x", Format: FormatValue, Err: errors.New("bad")}
+	if got, want := ierr.Error(), "invalid OrderStatus value x: bad"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestEventError(t *testing.T) {
+	var err error = &EventError{Type: "OrderStatus", State: "Delivered", Event: "cancel"}
+	if !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("errors.Is(%v, ErrInvalidTransition) = false", err)
+	}
+	if errors.Is(err, ErrUnknownValue) {
+		t.Errorf("errors.Is(%v, ErrUnknownValue) = true", err)
+	}
+	if got, want := err.Error(), "OrderStatus: event cancel is not available in state Delivered"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+// decodeXMLElement 使用 UnmarshalXML 解码 input 的根元素
+func decodeXMLElement(input string) (*testStatus, error) {
+	d := xml.NewDecoder(strings.NewReader(input))
+	tok, err := d.Token()
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalXML(testStatus{}, d, tok.(xml.StartElement))
+}
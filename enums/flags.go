@@ -0,0 +1,177 @@
+package enums
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"iter"
+	"strings"
+)
+
+// Integer is the constraint for the underlying type of bit-flag enums.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// FlagSeparator separates flag names in the string form of a flag set.
+const FlagSeparator = "|"
+
+// FlagValues returns the flags of e that are set in mask, in declaration order.
+func FlagValues[R Integer, T comparable, E Enum[R, T]](e E, mask R) iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for v := range e.All() {
+			en, ok := any(v).(E)
+			if !ok {
+				continue
+			}
+			if bit := en.Val(); bit != 0 && mask&bit == bit {
+				if !yield(en) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// FlagsMask returns the mask with every flag of e set.
+func FlagsMask[R Integer, T comparable, E Enum[R, T]](e E) R {
+	var mask R
+	for v := range e.All() {
+		if en, ok := any(v).(E); ok {
+			mask |= en.Val()
+		}
+	}
+	return mask
+}
+
+// FormatFlags returns the names of the flags set in mask joined by FlagSeparator.
+func FormatFlags[R Integer, T comparable, E Enum[R, T]](e E, mask R) string {
+	var names []string
+	for v := range FlagValues(e, mask) {
+		names = append(names, v.Name())
+	}
+	return strings.Join(names, FlagSeparator)
+}
+
+// ParseFlags parses flag names separated by FlagSeparator into a mask.
+// An empty string yields an empty mask.
+func ParseFlags[R Integer, T comparable, E Enum[R, T]](e E, s string) (R, error) {
+	var mask R
+	if strings.TrimSpace(s) == "" {
+		return mask, nil
+	}
+	for _, name := range strings.Split(s, FlagSeparator) {
+		bit, ok := flagFromName(e, strings.TrimSpace(name))
+		if !ok {
+			return 0, unknownValue[E](strings.TrimSpace(name), FormatName)
+		}
+		mask |= bit
+	}
+	return mask, nil
+}
+
+// flagFromName returns the bit of the flag called name.
+func flagFromName[R Integer, T comparable, E Enum[R, T]](e E, name string) (R, bool) {
+	ret, ok := e.FromName(name)
+	if !ok {
+		return 0, false
+	}
+	en, ok := any(ret).(E)
+	if !ok {
+		return 0, false
+	}
+	return en.Val(), true
+}
+
+// checkFlagsMask verifies that mask only contains bits of known flags.
+func checkFlagsMask[R Integer, T comparable, E Enum[R, T]](e E, mask R) (R, error) {
+	if mask&^FlagsMask(e) != 0 {
+		return 0, unknownValue[E](mask, FormatValue)
+	}
+	return mask, nil
+}
+
+// MarshalFlagsJSON serializes a flag set as an array of names or as the
+// integer mask, depending on the SerdeFormat of the flags.
+func MarshalFlagsJSON[R Integer, T comparable, E Enum[R, T]](e E, mask R) ([]byte, error) {
+	if e.SerdeFormat() == FormatName {
+		names := []string{}
+		for v := range FlagValues(e, mask) {
+			names = append(names, v.Name())
+		}
+		return json.Marshal(names)
+	}
+	return json.Marshal(mask)
+}
+
+// UnmarshalFlagsJSON is the inverse of MarshalFlagsJSON.
+func UnmarshalFlagsJSON[R Integer, T comparable, E Enum[R, T]](e E, bs []byte) (R, error) {
+	if e.SerdeFormat() == FormatName {
+		var names []string
+		if err := json.Unmarshal(bs, &names); err != nil {
+			return 0, invalidValue[E](string(bs), FormatName, err)
+		}
+		var mask R
+		for _, name := range names {
+			bit, ok := flagFromName(e, name)
+			if !ok {
+				return 0, unknownValue[E](name, FormatName)
+			}
+			mask |= bit
+		}
+		return mask, nil
+	}
+	var mask R
+	if err := json.Unmarshal(bs, &mask); err != nil {
+		return 0, invalidValue[E](string(bs), FormatValue, err)
+	}
+	return checkFlagsMask(e, mask)
+}
+
+// MarshalFlagsText serializes a flag set as "A|B" or as the decimal mask.
+func MarshalFlagsText[R Integer, T comparable, E Enum[R, T]](e E, mask R) ([]byte, error) {
+	if e.SerdeFormat() == FormatName {
+		return []byte(FormatFlags(e, mask)), nil
+	}
+	bs, err := anyToString(mask)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(bs), nil
+}
+
+// UnmarshalFlagsText is the inverse of MarshalFlagsText.
+func UnmarshalFlagsText[R Integer, T comparable, E Enum[R, T]](e E, bs []byte) (R, error) {
+	if e.SerdeFormat() == FormatName {
+		return ParseFlags(e, string(bs))
+	}
+	var mask R
+	if err := parseStringValue(string(bs), &mask); err != nil {
+		return 0, invalidValue[E](string(bs), FormatValue, err)
+	}
+	return checkFlagsMask(e, mask)
+}
+
+// FlagsSQLValue stores a flag set as "A|B" text or as the integer mask.
+func FlagsSQLValue[R Integer, T comparable, E Enum[R, T]](e E, mask R) (driver.Value, error) {
+	if e.SerdeFormat() == FormatName {
+		return FormatFlags(e, mask), nil
+	}
+	return int64(mask), nil
+}
+
+// FlagsSQLScan is the inverse of FlagsSQLValue.
+func FlagsSQLScan[R Integer, T comparable, E Enum[R, T]](e E, src any) (R, error) {
+	if e.SerdeFormat() == FormatName {
+		var str string
+		if err := NewScanner(&str).Scan(src); err != nil {
+			return 0, invalidValue[E](src, FormatName, err)
+		}
+		return ParseFlags(e, str)
+	}
+	var mask R
+	if err := NewScanner(&mask).Scan(src); err != nil {
+		return 0, invalidValue[E](src, FormatValue, err)
+	}
+	return checkFlagsMask(e, mask)
+}
@@ -0,0 +1,148 @@
+package enums
+
+import (
+	"testing"
+)
+
+func TestFormatAndParseFlags(t *testing.T) {
+	tests := []struct {
+		mask     uint8
+		expected string
+	}{
+		{0, ""},
+		{1, "Read"},
+		{3, "Read|Write"},
+		{7, "Read|Write|Exec"},
+		{5, "Read|Exec"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if got := FormatFlags(testPerm{}, tt.mask); got != tt.expected {
+				t.Errorf("FormatFlags(%d) = %q, want %q", tt.mask, got, tt.expected)
+			}
+			mask, err := ParseFlags(testPerm{}, tt.expected)
+			if err != nil {
+				t.Fatalf("ParseFlags(%q) failed: %v", tt.expected, err)
+			}
+			if mask != tt.mask {
+				t.Errorf("ParseFlags(%q) = %d, want %d", tt.expected, mask, tt.mask)
+			}
+		})
+	}
+
+	t.Run("spaces", func(t *testing.T) {
+		mask, err := ParseFlags(testPerm{}, " Read | Exec ")
+		if err != nil || mask != 5 {
+			t.Errorf("ParseFlags = %d, %v; want 5", mask, err)
+		}
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		if _, err := ParseFlags(testPerm{}, "Read|Delete"); err == nil {
+			t.Error("ParseFlags should fail for unknown flag")
+		}
+	})
+}
+
+func TestFlagsMask(t *testing.T) {
+	if got := FlagsMask(testPerm{}); got != 7 {
+		t.Errorf("FlagsMask = %d, want 7", got)
+	}
+}
+
+func TestFlagsJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   Format
+		mask     uint8
+		expected string
+	}{
+		{"value", FormatValue, 3, `3`},
+		{"value empty", FormatValue, 0, `0`},
+		{"name", FormatName, 3, `["Read","Write"]`},
+		{"name empty", FormatName, 0, `[]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := testPerm{format: tt.format}
+			data, err := MarshalFlagsJSON(e, tt.mask)
+			if err != nil {
+				t.Fatalf("MarshalFlagsJSON failed: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("MarshalFlagsJSON = %s, want %s", data, tt.expected)
+			}
+			mask, err := UnmarshalFlagsJSON(e, data)
+			if err != nil {
+				t.Fatalf("UnmarshalFlagsJSON failed: %v", err)
+			}
+			if mask != tt.mask {
+				t.Errorf("UnmarshalFlagsJSON = %d, want %d", mask, tt.mask)
+			}
+		})
+	}
+
+	t.Run("unknown bits", func(t *testing.T) {
+		if _, err := UnmarshalFlagsJSON(testPerm{format: FormatValue}, []byte(`9`)); err == nil {
+			t.Error("UnmarshalFlagsJSON should fail for unknown bits")
+		}
+	})
+
+	t.Run("unknown name", func(t *testing.T) {
+		if _, err := UnmarshalFlagsJSON(testPerm{format: FormatName}, []byte(`["Delete"]`)); err == nil {
+			t.Error("UnmarshalFlagsJSON should fail for unknown name")
+		}
+	})
+}
+
+func TestFlagsTextAndSQL(t *testing.T) {
+	t.Run("text value", func(t *testing.T) {
+		e := testPerm{format: FormatValue}
+		data, err := MarshalFlagsText(e, uint8(6))
+		if err != nil || string(data) != "6" {
+			t.Fatalf("MarshalFlagsText = %s, %v; want 6", data, err)
+		}
+		mask, err := UnmarshalFlagsText(e, data)
+		if err != nil || mask != 6 {
+			t.Errorf("UnmarshalFlagsText = %d, %v; want 6", mask, err)
+		}
+	})
+
+	t.Run("text name", func(t *testing.T) {
+		e := testPerm{format: FormatName}
+		data, err := MarshalFlagsText(e, uint8(6))
+		if err != nil || string(data) != "Write|Exec" {
+			t.Fatalf("MarshalFlagsText = %s, %v; want Write|Exec", data, err)
+		}
+		mask, err := UnmarshalFlagsText(e, data)
+		if err != nil || mask != 6 {
+			t.Errorf("UnmarshalFlagsText = %d, %v; want 6", mask, err)
+		}
+	})
+
+	t.Run("sql value", func(t *testing.T) {
+		e := testPerm{format: FormatValue}
+		value, err := FlagsSQLValue(e, uint8(5))
+		if err != nil || value != int64(5) {
+			t.Fatalf("FlagsSQLValue = %v, %v; want 5", value, err)
+		}
+		mask, err := FlagsSQLScan(e, int64(5))
+		if err != nil || mask != 5 {
+			t.Errorf("FlagsSQLScan = %d, %v; want 5", mask, err)
+		}
+	})
+
+	t.Run("sql name", func(t *testing.T) {
+		e := testPerm{format: FormatName}
+		value, err := FlagsSQLValue(e, uint8(5))
+		if err != nil || value != "Read|Exec" {
+			t.Fatalf("FlagsSQLValue = %v, %v; want Read|Exec", value, err)
+		}
+		mask, err := FlagsSQLScan(e, []byte("Read|Exec"))
+		if err != nil || mask != 5 {
+			t.Errorf("FlagsSQLScan = %d, %v; want 5", mask, err)
+		}
+	})
+}
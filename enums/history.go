@@ -0,0 +1,122 @@
+package enums
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Transition is a state change recorded in a History.
+type Transition[E any] struct {
+	From   E
+	To     E
+	At     time.Time
+	Reason string
+}
+
+// History is an append-only log of the transitions of a state machine. It
+// is safe for concurrent use and the zero value is an empty history.
+//
+// A History is serialized as a JSON array of {"from", "to", "at", "reason"}
+// objects, with states written according to their SerdeFormat.
+type History[R comparable, E State[R, E]] struct {
+	mu      sync.Mutex
+	entries []Transition[E]
+}
+
+// Record appends a transition from `from` to `to` that happened now.
+func (h *History[R, E]) Record(from, to E, reason string) {
+	h.Append(Transition[E]{From: from, To: to, At: time.Now(), Reason: reason})
+}
+
+// Append appends t to the history.
+func (h *History[R, E]) Append(t Transition[E]) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.entries = append(h.entries, t)
+}
+
+// Transitions returns a copy of the recorded transitions, oldest first.
+func (h *History[R, E]) Transitions() []Transition[E] {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]Transition[E](nil), h.entries...)
+}
+
+// Len returns the number of recorded transitions.
+func (h *History[R, E]) Len() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.entries)
+}
+
+// Replay validates the history against the state machine, starting in
+// initial. Every transition must start in the state the previous one ended
+// in and must be allowed by CanTransitionTo. It returns the final state, or
+// an error matching ErrInvalidTransition for the first invalid entry.
+func (h *History[R, E]) Replay(initial E) (E, error) {
+	state := initial
+	for i, t := range h.Transitions() {
+		if t.From != state {
+			return state, fmt.Errorf("history entry %d starts in %v, but the state is %v: %w", i, t.From, state, ErrInvalidTransition)
+		}
+		if !t.From.CanTransitionTo(t.To) {
+			return state, fmt.Errorf("history entry %d: %w", i, &TransitionError{Type: typeName[E](), From: t.From, To: t.To})
+		}
+		state = t.To
+	}
+	return state, nil
+}
+
+// historyEntry is the JSON form of a Transition.
+type historyEntry struct {
+	From   json.RawMessage `json:"from"`
+	To     json.RawMessage `json:"to"`
+	At     time.Time       `json:"at"`
+	Reason string          `json:"reason,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (h *History[R, E]) MarshalJSON() ([]byte, error) {
+	transitions := h.Transitions()
+	entries := make([]historyEntry, len(transitions))
+	for i, t := range transitions {
+		from, err := MarshalJSON(t.From, t.From.Val())
+		if err != nil {
+			return nil, err
+		}
+		to, err := MarshalJSON(t.To, t.To.Val())
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = historyEntry{From: from, To: to, At: t.At, Reason: t.Reason}
+	}
+	return json.Marshal(entries)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It replaces the
+// recorded transitions.
+func (h *History[R, E]) UnmarshalJSON(data []byte) error {
+	var entries []historyEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	var zero E
+	transitions := make([]Transition[E], len(entries))
+	for i, e := range entries {
+		from, err := UnmarshalJSON(zero, e.From)
+		if err != nil {
+			return err
+		}
+		to, err := UnmarshalJSON(zero, e.To)
+		if err != nil {
+			return err
+		}
+		transitions[i] = Transition[E]{From: *from, To: *to, At: e.At, Reason: e.Reason}
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.entries = transitions
+	return nil
+}
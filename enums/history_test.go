@@ -0,0 +1,99 @@
+package enums
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMachineRecord(t *testing.T) {
+	var h History[int, testStatus]
+	m := NewMachine[int](statusPending)
+	m.Record(&h)
+
+	if err := m.TransitionWithReason(statusActive, "approved"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.TransitionTo(statusPending); err != nil {
+		t.Fatal(err)
+	}
+	// 非法转换不记录
+	if err := m.TransitionTo(statusDone); err == nil {
+		t.Fatal("TransitionTo(Done) from Pending succeeded")
+	}
+
+	got := h.Transitions()
+	if len(got) != 2 {
+		t.Fatalf("recorded %d transitions, want 2", len(got))
+	}
+	if got[0].From != statusPending || got[0].To != statusActive || got[0].Reason != "approved" || got[0].At.IsZero() {
+		t.Errorf("first transition = %+v", got[0])
+	}
+	if got[1].From != statusActive || got[1].To != statusPending || got[1].Reason != "" {
+		t.Errorf("second transition = %+v", got[1])
+	}
+}
+
+func TestHistoryJSON(t *testing.T) {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	var h History[int, testStatus]
+	h.Append(Transition[testStatus]{From: statusPending, To: statusActive, At: at, Reason: "approved"})
+	h.Append(Transition[testStatus]{From: statusActive, To: statusDone, At: at})
+
+	data, err := json.Marshal(&h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// testStatus 使用 FormatValue 序列化
+	want := `[{"from":1,"to":2,"at":"2024-01-02T03:04:05Z","reason":"approved"},{"from":2,"to":3,"at":"2024-01-02T03:04:05Z"}]`
+	if string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+
+	var decoded History[int, testStatus]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	got := decoded.Transitions()
+	if len(got) != 2 || got[0] != h.Transitions()[0] || got[1] != h.Transitions()[1] {
+		t.Errorf("Unmarshal = %+v, want %+v", got, h.Transitions())
+	}
+
+	err = json.Unmarshal([]byte(`[{"from":1,"to":9,"at":"2024-01-02T03:04:05Z"}]`), &decoded)
+	if !errors.Is(err, ErrUnknownValue) {
+		t.Errorf("Unmarshal unknown state = %v, want ErrUnknownValue", err)
+	}
+}
+
+func TestHistoryReplay(t *testing.T) {
+	tests := []struct {
+		name    string
+		steps   [][2]testStatus
+		want    testStatus
+		wantErr bool
+	}{
+		{"空历史", nil, statusPending, false},
+		{"合法转换", [][2]testStatus{{statusPending, statusActive}, {statusActive, statusDone}}, statusDone, false},
+		{"非法转换", [][2]testStatus{{statusPending, statusDone}}, statusPending, true},
+		{"不连续", [][2]testStatus{{statusPending, statusActive}, {statusPending, statusActive}}, statusActive, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var h History[int, testStatus]
+			for _, step := range tt.steps {
+				h.Record(step[0], step[1], "")
+			}
+			got, err := h.Replay(statusPending)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Replay() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidTransition) {
+				t.Errorf("errors.Is(%v, ErrInvalidTransition) = false", err)
+			}
+			if got != tt.want {
+				t.Errorf("Replay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
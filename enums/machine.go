@@ -0,0 +1,117 @@
+package enums
+
+import (
+	"sync"
+)
+
+// State is the constraint for enums generated with -statemachine.
+type State[R comparable, E comparable] interface {
+	Element[R, E]
+	CanTransitionTo(target E) bool
+}
+
+// Machine holds the current state of an enum state machine and only moves
+// along transitions allowed by CanTransitionTo. It is safe for concurrent use.
+//
+// Guards and hooks run while the machine is locked, in the order they were
+// registered, so they must not call methods of the machine. The from and to
+// states are passed to them instead.
+type Machine[R comparable, E State[R, E]] struct {
+	mu      sync.Mutex
+	current E
+	guards  []func(from, to E) error
+	onExit  map[E][]func(from, to E)
+	onEnter map[E][]func(from, to E)
+	history *History[R, E]
+}
+
+// NewMachine returns a machine in the initial state.
+func NewMachine[R comparable, E State[R, E]](initial E) *Machine[R, E] {
+	return &Machine[R, E]{
+		current: initial,
+		onExit:  make(map[E][]func(from, to E)),
+		onEnter: make(map[E][]func(from, to E)),
+	}
+}
+
+// Current returns the current state.
+func (m *Machine[R, E]) Current() E {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.current
+}
+
+// CanTransitionTo returns true if the state machine allows a transition from
+// the current state to target. Guards are not consulted.
+func (m *Machine[R, E]) CanTransitionTo(target E) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.current.CanTransitionTo(target)
+}
+
+// Guard registers fn to be called before every transition. A non-nil error
+// vetoes the transition and is returned wrapped in a *TransitionError.
+func (m *Machine[R, E]) Guard(fn func(from, to E) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.guards = append(m.guards, fn)
+}
+
+// OnExit registers fn to be called when the machine leaves state.
+func (m *Machine[R, E]) OnExit(state E, fn func(from, to E)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onExit[state] = append(m.onExit[state], fn)
+}
+
+// OnEnter registers fn to be called when the machine enters state.
+func (m *Machine[R, E]) OnEnter(state E, fn func(from, to E)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onEnter[state] = append(m.onEnter[state], fn)
+}
+
+// Record makes the machine append every successful transition to h. Pass
+// nil to stop recording.
+func (m *Machine[R, E]) Record(h *History[R, E]) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.history = h
+}
+
+// TransitionTo moves the machine to target. It returns a *TransitionError if
+// the transition is not allowed or a guard vetoes it, in which case the
+// state is unchanged. Otherwise the OnExit hooks of the current state run,
+// the state changes and the OnEnter hooks of target run.
+func (m *Machine[R, E]) TransitionTo(target E) error {
+	return m.TransitionWithReason(target, "")
+}
+
+// TransitionWithReason is like TransitionTo, but stores reason with the
+// transition in the recorded History.
+func (m *Machine[R, E]) TransitionWithReason(target E, reason string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	from := m.current
+	if !from.CanTransitionTo(target) {
+		return &TransitionError{Type: typeName[E](), From: from, To: target}
+	}
+	for _, guard := range m.guards {
+		if err := guard(from, target); err != nil {
+			return &TransitionError{Type: typeName[E](), From: from, To: target, Err: err}
+		}
+	}
+
+	for _, fn := range m.onExit[from] {
+		fn(from, target)
+	}
+	m.current = target
+	if m.history != nil {
+		m.history.Record(from, target, reason)
+	}
+	for _, fn := range m.onEnter[target] {
+		fn(from, target)
+	}
+	return nil
+}
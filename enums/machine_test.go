@@ -0,0 +1,113 @@
+package enums
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+// CanTransitionTo 允许 Pending -> Active -> Done 以及 Active -> Pending
+func (s testStatus) CanTransitionTo(target testStatus) bool {
+	switch s {
+	case statusPending:
+		return target == statusActive
+	case statusActive:
+		return target == statusDone || target == statusPending
+	}
+	return false
+}
+
+func TestMachineTransitions(t *testing.T) {
+	m := NewMachine[int](statusPending)
+	if err := m.TransitionTo(statusActive); err != nil {
+		t.Fatalf("TransitionTo(Active) failed: %v", err)
+	}
+	if m.Current() != statusActive {
+		t.Errorf("Current() = %v, want Active", m.Current())
+	}
+
+	m = NewMachine[int](statusPending)
+	err := m.TransitionTo(statusDone)
+	if !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("errors.Is(%v, ErrInvalidTransition) = false", err)
+	}
+	var terr *TransitionError
+	if !errors.As(err, &terr) || terr.From != statusPending || terr.To != statusDone || terr.Err != nil {
+		t.Errorf("got %#v, want From Pending, To Done", terr)
+	}
+	if got, want := err.Error(), "testStatus: invalid transition from Pending to Done"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if m.Current() != statusPending {
+		t.Errorf("state changed after invalid transition: %v", m.Current())
+	}
+}
+
+func TestMachineHooks(t *testing.T) {
+	m := NewMachine[int](statusPending)
+	var calls []string
+	m.OnExit(statusPending, func(from, to testStatus) {
+		calls = append(calls, "exit "+from.Name()+" "+to.Name())
+	})
+	m.OnEnter(statusActive, func(from, to testStatus) {
+		calls = append(calls, "enter "+from.Name()+" "+to.Name())
+	})
+	m.OnEnter(statusDone, func(from, to testStatus) {
+		calls = append(calls, "enter Done")
+	})
+
+	if err := m.TransitionTo(statusActive); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"exit Pending Active", "enter Pending Active"}
+	if len(calls) != len(want) || calls[0] != want[0] || calls[1] != want[1] {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestMachineGuard(t *testing.T) {
+	errBusy := errors.New("busy")
+	m := NewMachine[int](statusPending)
+	entered := false
+	m.OnEnter(statusActive, func(from, to testStatus) { entered = true })
+	m.Guard(func(from, to testStatus) error {
+		if to == statusActive {
+			return errBusy
+		}
+		return nil
+	})
+
+	err := m.TransitionTo(statusActive)
+	if !errors.Is(err, errBusy) || !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("TransitionTo(Active) = %v, want wrapped errBusy", err)
+	}
+	if entered || m.Current() != statusPending {
+		t.Errorf("vetoed transition changed the machine: entered=%v current=%v", entered, m.Current())
+	}
+	if !m.CanTransitionTo(statusActive) {
+		t.Error("CanTransitionTo(Active) = false, guards should not be consulted")
+	}
+}
+
+func TestMachineConcurrent(t *testing.T) {
+	m := NewMachine[int](statusPending)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	succeeded := 0
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if m.TransitionTo(statusActive) == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	// 只有第一个转换成功，之后 Active -> Active 不被允许
+	if succeeded != 1 {
+		t.Errorf("%d transitions succeeded, want 1", succeeded)
+	}
+}
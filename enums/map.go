@@ -0,0 +1,279 @@
+package enums
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Map maps enum values to values of type V. Entries are stored in a dense
+// slice indexed by declaration order, and iteration follows that order.
+// Keys that are not returned by All(), such as invalid values, are ignored.
+//
+// The zero value is an empty map. Copies of a map share storage, so use
+// Clone before modifying a copy independently.
+type Map[R comparable, E Element[R, E], V any] struct {
+	keys   Set[R, E]
+	values []V
+}
+
+// NewMap returns an empty map.
+func NewMap[R comparable, E Element[R, E], V any]() Map[R, E, V] {
+	return Map[R, E, V]{}
+}
+
+// Set associates value with key.
+func (m *Map[R, E, V]) Set(key E, value V) {
+	i, ok := ordinals[R, E]().index[key]
+	if !ok {
+		return
+	}
+	for len(m.values) <= i {
+		var zero V
+		m.values = append(m.values, zero)
+	}
+	m.values[i] = value
+	m.keys.Add(key)
+}
+
+// Get returns the value associated with key.
+func (m Map[R, E, V]) Get(key E) (V, bool) {
+	var zero V
+	if !m.keys.Contains(key) {
+		return zero, false
+	}
+	return m.values[ordinals[R, E]().index[key]], true
+}
+
+// Has returns true if the map contains key.
+func (m Map[R, E, V]) Has(key E) bool {
+	return m.keys.Contains(key)
+}
+
+// Delete removes key from the map.
+func (m *Map[R, E, V]) Delete(key E) {
+	if !m.keys.Contains(key) {
+		return
+	}
+	var zero V
+	m.values[ordinals[R, E]().index[key]] = zero
+	m.keys.Remove(key)
+}
+
+// Len returns the number of entries in the map.
+func (m Map[R, E, V]) Len() int {
+	return m.keys.Len()
+}
+
+// Clear removes all entries from the map.
+func (m *Map[R, E, V]) Clear() {
+	m.keys.Clear()
+	clear(m.values)
+}
+
+// Keys returns the keys of the map in declaration order.
+func (m Map[R, E, V]) Keys() iter.Seq[E] {
+	return m.keys.Values()
+}
+
+// KeySet returns a copy of the keys as a Set.
+func (m Map[R, E, V]) KeySet() Set[R, E] {
+	return m.keys.Clone()
+}
+
+// All returns the entries of the map in declaration order.
+func (m Map[R, E, V]) All() iter.Seq2[E, V] {
+	return func(yield func(E, V) bool) {
+		idx := ordinals[R, E]()
+		for k := range m.keys.Values() {
+			if !yield(k, m.values[idx.index[k]]) {
+				return
+			}
+		}
+	}
+}
+
+// Clone returns an independent shallow copy of the map.
+func (m Map[R, E, V]) Clone() Map[R, E, V] {
+	return Map[R, E, V]{
+		keys:   m.keys.Clone(),
+		values: append([]V(nil), m.values...),
+	}
+}
+
+// mapKey returns the JSON object key of k according to its SerdeFormat.
+func mapKey[R comparable, E Element[R, E]](k E) (string, error) {
+	if k.SerdeFormat() == FormatName {
+		return k.Name(), nil
+	}
+	return anyToString(k.Val())
+}
+
+// parseMapKey is the inverse of mapKey.
+func parseMapKey[R comparable, E Element[R, E]](key string) (E, error) {
+	var zero E
+	if zero.SerdeFormat() == FormatName {
+		v, err := findNameOrValue(zero, key, true)
+		if err != nil {
+			return zero, err
+		}
+		return *v, nil
+	}
+	var raw R
+	if err := parseStringValue(key, &raw); err != nil {
+		return zero, invalidValue[E](key, FormatValue, err)
+	}
+	v, err := findNameOrValue(zero, raw, false)
+	if err != nil {
+		return zero, err
+	}
+	return *v, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. The map is written as
+// a JSON object in declaration order, keyed by name or by value depending on
+// the SerdeFormat of the keys.
+func (m Map[R, E, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	first := true
+	for k, v := range m.All() {
+		key, err := mapKey(k)
+		if err != nil {
+			return nil, err
+		}
+		keyData, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		valueData, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		buf.Write(keyData)
+		buf.WriteByte(':')
+		buf.Write(valueData)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (m *Map[R, E, V]) UnmarshalJSON(data []byte) error {
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	result := Map[R, E, V]{}
+	for key, raw := range entries {
+		k, err := parseMapKey[R, E](key)
+		if err != nil {
+			return err
+		}
+		var v V
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return err
+		}
+		result.Set(k, v)
+	}
+	*m = result
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface. YAML encoders sort
+// the keys of Go maps, so the entries are returned as the fields of a struct
+// built at run time, which are encoded as a mapping in declaration order.
+func (m Map[R, E, V]) MarshalYAML() (any, error) {
+	var fields []reflect.StructField
+	var values []V
+	// Keys that cannot be expressed as a yaml field tag go in an inline map
+	// after the other entries.
+	var rest map[string]V
+	for k, v := range m.All() {
+		key, err := mapKey(k)
+		if err != nil {
+			return nil, err
+		}
+		if key == "" || key == "-" || strings.Contains(key, ",") {
+			if rest == nil {
+				rest = make(map[string]V)
+			}
+			rest[key] = v
+			continue
+		}
+		fields = append(fields, reflect.StructField{
+			Name: "F" + strconv.Itoa(len(fields)),
+			Type: reflect.TypeFor[V](),
+			Tag:  reflect.StructTag("yaml:" + strconv.Quote(key)),
+		})
+		values = append(values, v)
+	}
+	if rest != nil {
+		fields = append(fields, reflect.StructField{
+			Name: "Rest",
+			Type: reflect.TypeFor[map[string]V](),
+			Tag:  `yaml:",inline"`,
+		})
+	}
+	entries := reflect.New(reflect.StructOf(fields)).Elem()
+	for i, v := range values {
+		entries.Field(i).Set(reflect.ValueOf(&v).Elem())
+	}
+	if rest != nil {
+		entries.Field(len(values)).Set(reflect.ValueOf(rest))
+	}
+	return entries.Interface(), nil
+}
+
+// UnmarshalYAML implements the legacy yaml.Unmarshaler interface, which is
+// supported by both gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (m *Map[R, E, V]) UnmarshalYAML(unmarshal func(any) error) error {
+	var entries map[string]V
+	if err := unmarshal(&entries); err != nil {
+		return err
+	}
+	result := Map[R, E, V]{}
+	for key, v := range entries {
+		k, err := parseMapKey[R, E](key)
+		if err != nil {
+			return err
+		}
+		result.Set(k, v)
+	}
+	*m = result
+	return nil
+}
+
+// Value implements the driver.Valuer interface. The map is stored as a JSON object.
+func (m Map[R, E, V]) Value() (driver.Value, error) {
+	data, err := m.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for JSON columns.
+func (m *Map[R, E, V]) Scan(src any) error {
+	if src == nil {
+		*m = Map[R, E, V]{}
+		return nil
+	}
+	switch data := src.(type) {
+	case []byte:
+		return m.UnmarshalJSON(data)
+	case string:
+		return m.UnmarshalJSON([]byte(data))
+	default:
+		return fmt.Errorf("cannot scan %T into map", src)
+	}
+}
@@ -0,0 +1,137 @@
+package enums
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	var m Map[int, testStatus, string]
+	m.Set(statusDone, "done")
+	m.Set(statusPending, "pending")
+
+	if v, ok := m.Get(statusDone); !ok || v != "done" {
+		t.Errorf("Get(Done) = %q, %v", v, ok)
+	}
+	if _, ok := m.Get(statusActive); ok {
+		t.Error("Get(Active) should not be found")
+	}
+	if m.Len() != 2 {
+		t.Errorf("expected 2 entries, got %d", m.Len())
+	}
+
+	// 迭代顺序与声明顺序一致
+	var keys []testStatus
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+	if len(keys) != 2 || keys[0] != statusPending || keys[1] != statusDone {
+		t.Errorf("expected declaration order, got %v", keys)
+	}
+
+	m.Delete(statusPending)
+	if m.Has(statusPending) || m.Len() != 1 {
+		t.Error("Delete failed")
+	}
+
+	c := m.Clone()
+	c.Set(statusActive, "active")
+	if m.Has(statusActive) {
+		t.Error("modifying a clone must not affect the original")
+	}
+
+	m.Set(testStatus{99}, "invalid")
+	if m.Len() != 1 {
+		t.Error("invalid key should be ignored")
+	}
+}
+
+func TestMapSerialization(t *testing.T) {
+	t.Run("JSON按值", func(t *testing.T) {
+		m := NewMap[int, testStatus, int]()
+		m.Set(statusDone, 3)
+		m.Set(statusPending, 1)
+		data, err := json.Marshal(m)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		if string(data) != `{"1":1,"3":3}` {
+			t.Errorf(`expected {"1":1,"3":3}, got %s`, data)
+		}
+		var back Map[int, testStatus, int]
+		if err := json.Unmarshal(data, &back); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if v, _ := back.Get(statusDone); back.Len() != 2 || v != 3 {
+			t.Errorf("unexpected result: %v", back)
+		}
+	})
+
+	t.Run("JSON按名称", func(t *testing.T) {
+		m := NewMap[string, testColorByName, bool]()
+		m.Set(testColorByName{testColor{"green"}}, true)
+		data, err := json.Marshal(m)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		if string(data) != `{"Green":true}` {
+			t.Errorf(`expected {"Green":true}, got %s`, data)
+		}
+		var back Map[string, testColorByName, bool]
+		if err := json.Unmarshal(data, &back); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if v, ok := back.Get(testColorByName{testColor{"green"}}); !ok || !v {
+			t.Errorf("unexpected result: %v", back)
+		}
+	})
+
+	t.Run("JSON未知键", func(t *testing.T) {
+		var m Map[int, testStatus, int]
+		if err := json.Unmarshal([]byte(`{"42":1}`), &m); err == nil {
+			t.Error("Unmarshal should fail for unknown key")
+		}
+	})
+
+	t.Run("YAML声明顺序", func(t *testing.T) {
+		m := NewMap[string, testColor, int]()
+		m.Set(testColor{"blue"}, 3)
+		m.Set(testColor{"red"}, 1)
+		m.Set(testColor{"green"}, 2)
+		out, err := m.MarshalYAML()
+		if err != nil {
+			t.Fatalf("MarshalYAML failed: %v", err)
+		}
+		// YAML 编码器按字段顺序输出结构体
+		v := reflect.ValueOf(out)
+		if v.Kind() != reflect.Struct {
+			t.Fatalf("expected a struct, got %T", out)
+		}
+		var got []string
+		for i := range v.NumField() {
+			got = append(got, fmt.Sprintf("%s=%v", v.Type().Field(i).Tag.Get("yaml"), v.Field(i)))
+		}
+		if want := []string{"red=1", "green=2", "blue=3"}; !slices.Equal(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		m := NewMap[int, testStatus, string]()
+		m.Set(statusActive, "a")
+		value, err := m.Value()
+		if err != nil || value != `{"2":"a"}` {
+			t.Fatalf("Value = %v, %v", value, err)
+		}
+		var back Map[int, testStatus, string]
+		if err := back.Scan(value); err != nil {
+			t.Fatalf("Scan failed: %v", err)
+		}
+		if v, _ := back.Get(statusActive); v != "a" {
+			t.Errorf("unexpected result: %v", back)
+		}
+	})
+}
@@ -0,0 +1,192 @@
+package enums
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// MarshalMsgpack encodes e as MessagePack: a string with FormatName, and the
+// value with FormatValue, using the smallest integer encoding that holds it.
+// b is the raw value of e, see MarshalJSON.
+func MarshalMsgpack[R comparable, T comparable, E Enum[R, T]](e E, b any) ([]byte, error) {
+	if e.SerdeFormat() == FormatName {
+		return appendMsgpackString(nil, e.Name()), nil
+	}
+	value, err := scalarOf(b)
+	if err != nil {
+		return nil, err
+	}
+	return appendMsgpackScalar(nil, value), nil
+}
+
+// AppendMsgpack appends the MessagePack encoding of e to dst, the same bytes
+// MarshalMsgpack returns, without reflection for the basic base types.
+func AppendMsgpack[R comparable, T comparable, E Enum[R, T]](e E, dst []byte) ([]byte, error) {
+	if e.SerdeFormat() == FormatName {
+		return appendMsgpackString(dst, e.Name()), nil
+	}
+	value, err := scalarOf(e.Val())
+	if err != nil {
+		return nil, err
+	}
+	return appendMsgpackScalar(dst, value), nil
+}
+
+// appendMsgpackScalar appends a scalar returned by scalarOf.
+func appendMsgpackScalar(b []byte, v scalar) []byte {
+	switch v.kind {
+	case reflect.Int64:
+		return appendMsgpackInt(b, v.i)
+	case reflect.Uint64:
+		return appendMsgpackUint(b, v.u)
+	case reflect.Float32:
+		return binary.BigEndian.AppendUint32(append(b, 0xca), math.Float32bits(float32(v.f)))
+	case reflect.Float64:
+		return binary.BigEndian.AppendUint64(append(b, 0xcb), math.Float64bits(v.f))
+	default:
+		return appendMsgpackString(b, v.s)
+	}
+}
+
+// UnmarshalMsgpack decodes a MessagePack string or number as encoded by
+// MarshalMsgpack. Any integer or float encoding is accepted.
+func UnmarshalMsgpack[R comparable, T comparable, E Enum[R, T]](e E, data []byte) (*E, error) {
+	value, err := decodeMsgpack(data)
+	if err != nil {
+		return nil, invalidValue[E](data, e.SerdeFormat(), err)
+	}
+	return decodeScalar(e, value, data)
+}
+
+func appendMsgpackInt(b []byte, n int64) []byte {
+	switch {
+	case n >= 0:
+		return appendMsgpackUint(b, uint64(n))
+	case n >= -32:
+		return append(b, byte(n))
+	case n >= math.MinInt8:
+		return append(b, 0xd0, byte(n))
+	case n >= math.MinInt16:
+		return binary.BigEndian.AppendUint16(append(b, 0xd1), uint16(n))
+	case n >= math.MinInt32:
+		return binary.BigEndian.AppendUint32(append(b, 0xd2), uint32(n))
+	default:
+		return binary.BigEndian.AppendUint64(append(b, 0xd3), uint64(n))
+	}
+}
+
+func appendMsgpackUint(b []byte, n uint64) []byte {
+	switch {
+	case n <= 0x7f:
+		return append(b, byte(n))
+	case n <= math.MaxUint8:
+		return append(b, 0xcc, byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, 0xcd), uint16(n))
+	case n <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, 0xce), uint32(n))
+	default:
+		return binary.BigEndian.AppendUint64(append(b, 0xcf), n)
+	}
+}
+
+func appendMsgpackString(b []byte, s string) []byte {
+	switch n := len(s); {
+	case n < 32:
+		b = append(b, 0xa0|byte(n))
+	case n <= math.MaxUint8:
+		b = append(b, 0xd9, byte(n))
+	case n <= math.MaxUint16:
+		b = binary.BigEndian.AppendUint16(append(b, 0xda), uint16(n))
+	default:
+		b = binary.BigEndian.AppendUint32(append(b, 0xdb), uint32(n))
+	}
+	return append(b, s...)
+}
+
+var errMsgpackShort = errors.New("msgpack: unexpected end of data")
+
+// decodeMsgpack decodes a single MessagePack string, integer or float into
+// a string, int64, uint64 or float64.
+func decodeMsgpack(data []byte) (any, error) {
+	if len(data) == 0 {
+		return nil, errMsgpackShort
+	}
+	c, rest := data[0], data[1:]
+	// size returns the next n bytes as an unsigned integer
+	size := func(n int) (uint64, error) {
+		if len(rest) < n {
+			return 0, errMsgpackShort
+		}
+		var u uint64
+		for _, b := range rest[:n] {
+			u = u<<8 | uint64(b)
+		}
+		rest = rest[n:]
+		return u, nil
+	}
+	str := func(n uint64) (any, error) {
+		if uint64(len(rest)) < n {
+			return nil, errMsgpackShort
+		}
+		s := string(rest[:n])
+		rest = rest[n:]
+		return s, nil
+	}
+
+	var value any
+	var err error
+	switch {
+	case c <= 0x7f:
+		value = int64(c)
+	case c >= 0xe0:
+		value = int64(int8(c))
+	case c&0xe0 == 0xa0:
+		value, err = str(uint64(c & 0x1f))
+	default:
+		var u uint64
+		switch c {
+		case 0xcc, 0xcd, 0xce, 0xcf:
+			if u, err = size(1 << (c - 0xcc)); err == nil {
+				value = u
+				if u <= math.MaxInt64 {
+					value = int64(u)
+				}
+			}
+		case 0xd0:
+			u, err = size(1)
+			value = int64(int8(u))
+		case 0xd1:
+			u, err = size(2)
+			value = int64(int16(u))
+		case 0xd2:
+			u, err = size(4)
+			value = int64(int32(u))
+		case 0xd3:
+			u, err = size(8)
+			value = int64(u)
+		case 0xca:
+			u, err = size(4)
+			value = float64(math.Float32frombits(uint32(u)))
+		case 0xcb:
+			u, err = size(8)
+			value = math.Float64frombits(u)
+		case 0xd9, 0xda, 0xdb:
+			if u, err = size(1 << (c - 0xd9)); err == nil {
+				value, err = str(u)
+			}
+		default:
+			return nil, fmt.Errorf("msgpack: unexpected type byte 0x%02x", c)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("msgpack: trailing data")
+	}
+	return value, nil
+}
@@ -0,0 +1,137 @@
+package enums
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMsgpackIntegers(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want []byte
+	}{
+		{0, []byte{0x00}},
+		{127, []byte{0x7f}},
+		{128, []byte{0xcc, 0x80}},
+		{256, []byte{0xcd, 0x01, 0x00}},
+		{1 << 16, []byte{0xce, 0x00, 0x01, 0x00, 0x00}},
+		{1 << 32, []byte{0xcf, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00}},
+		{-1, []byte{0xff}},
+		{-32, []byte{0xe0}},
+		{-33, []byte{0xd0, 0xdf}},
+		{-129, []byte{0xd1, 0xff, 0x7f}},
+		{math.MinInt32, []byte{0xd2, 0x80, 0x00, 0x00, 0x00}},
+		{math.MinInt64, []byte{0xd3, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+	}
+	for _, tt := range tests {
+		got := appendMsgpackInt(nil, tt.n)
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("appendMsgpackInt(%d) = % x, want % x", tt.n, got, tt.want)
+		}
+		v, err := decodeMsgpack(got)
+		if err != nil || v != tt.n {
+			t.Errorf("decodeMsgpack(% x) = %v, %v, want %d", got, v, err, tt.n)
+		}
+	}
+
+	v, err := decodeMsgpack(appendMsgpackUint(nil, math.MaxUint64))
+	if err != nil || v != uint64(math.MaxUint64) {
+		t.Errorf("decodeMsgpack(MaxUint64) = %v, %v", v, err)
+	}
+}
+
+func TestMsgpackEnums(t *testing.T) {
+	t.Run("按值序列化", func(t *testing.T) {
+		data, err := MarshalMsgpack(statusActive, statusActive.Val())
+		if err != nil || !bytes.Equal(data, []byte{0x02}) {
+			t.Fatalf("MarshalMsgpack(Active) = % x, %v, want 02", data, err)
+		}
+		// 接受任意整数编码
+		for _, input := range [][]byte{{0x02}, {0xcc, 0x02}, {0xd3, 0, 0, 0, 0, 0, 0, 0, 0x02}} {
+			v, err := UnmarshalMsgpack(testStatus{}, input)
+			if err != nil || *v != statusActive {
+				t.Errorf("UnmarshalMsgpack(% x) = %v, %v, want Active", input, v, err)
+			}
+		}
+		// 整数枚举不接受浮点数
+		if _, err := UnmarshalMsgpack(testStatus{}, []byte{0xcb, 0x40, 0, 0, 0, 0, 0, 0, 0}); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("UnmarshalMsgpack(float) = %v, want ErrInvalidValue", err)
+		}
+	})
+
+	t.Run("字符串枚举", func(t *testing.T) {
+		red := testColor{"red"}
+		data, err := MarshalMsgpack(red, red.Val())
+		if err != nil || !bytes.Equal(data, []byte("\xa3red")) {
+			t.Fatalf("MarshalMsgpack(red) = % x, %v", data, err)
+		}
+		byName := testColorByName{red}
+		data, err = MarshalMsgpack(byName, byName.Val())
+		if err != nil || !bytes.Equal(data, []byte("\xa3Red")) {
+			t.Fatalf("MarshalMsgpack(Red) = % x, %v", data, err)
+		}
+		v, err := UnmarshalMsgpack(testColorByName{}, []byte("\xd9\x04Blue"))
+		if err != nil || v.Val() != "blue" {
+			t.Errorf("UnmarshalMsgpack(str8 Blue) = %v, %v", v, err)
+		}
+	})
+
+	t.Run("错误", func(t *testing.T) {
+		if _, err := UnmarshalMsgpack(testStatus{}, []byte{0x09}); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("unknown value: %v, want ErrUnknownValue", err)
+		}
+		for _, input := range [][]byte{nil, {0xc3}, {0x02, 0x00}, {0xcd, 0x01}} {
+			if _, err := UnmarshalMsgpack(testStatus{}, input); !errors.Is(err, ErrInvalidValue) {
+				t.Errorf("UnmarshalMsgpack(% x) = %v, want ErrInvalidValue", input, err)
+			}
+		}
+		if _, err := UnmarshalMsgpack(testColorByName{}, []byte{0x01}); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("integer for name format: %v, want ErrInvalidValue", err)
+		}
+	})
+}
+
+func TestSetScalar(t *testing.T) {
+	var i8 int8
+	if err := setScalar(int64(200), &i8); err == nil {
+		t.Error("setScalar(200, *int8) should overflow")
+	}
+	var u uint
+	if err := setScalar(int64(-1), &u); err == nil {
+		t.Error("setScalar(-1, *uint) should fail")
+	}
+	var f float32
+	if err := setScalar(int64(3), &f); err != nil || f != 3 {
+		t.Errorf("setScalar(3, *float32) = %v, %v", f, err)
+	}
+	var s string
+	if err := setScalar(int64(3), &s); err == nil {
+		t.Error("setScalar(3, *string) should fail")
+	}
+
+	// 基本类型不使用反射，结果应与命名类型的反射路径一致
+	type namedInt8 int8
+	type namedUint16 uint16
+	type namedFloat32 float32
+	type namedString string
+	for _, value := range []any{int64(-129), int64(-1), int64(7), int64(255), uint64(1 << 16), uint64(math.MaxUint64), 1.5, "a"} {
+		var (
+			i8   int8
+			ni8  namedInt8
+			u16  uint16
+			nu16 namedUint16
+			f32  float32
+			nf32 namedFloat32
+			str  string
+			nstr namedString
+		)
+		basic := [4]bool{setScalar(value, &i8) != nil, setScalar(value, &u16) != nil, setScalar(value, &f32) != nil, setScalar(value, &str) != nil}
+		named := [4]bool{setScalar(value, &ni8) != nil, setScalar(value, &nu16) != nil, setScalar(value, &nf32) != nil, setScalar(value, &nstr) != nil}
+		if basic != named || i8 != int8(ni8) || u16 != uint16(nu16) || f32 != float32(nf32) || str != string(nstr) {
+			t.Errorf("setScalar(%v): basic %v %v %v %v %q, named %v %v %v %v %q",
+				value, basic, i8, u16, f32, str, named, ni8, nu16, nf32, nstr)
+		}
+	}
+}
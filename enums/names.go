@@ -0,0 +1,29 @@
+package enums
+
+import (
+	"strings"
+	"unicode"
+)
+
+// FoldName returns the case-insensitive form of name. Enums generated with
+// -serde/ci look up names by this form, so "PENDING", "pending" and
+// "Pending" all match.
+func FoldName(name string) string {
+	return strings.ToLower(name)
+}
+
+// NormalizeName returns the lower-case form of name without word separators.
+// Enums generated with -serde/normalize look up names by this form, so
+// "InProgress", "in_progress", "in-progress" and "IN PROGRESS" all match.
+func NormalizeName(name string) string {
+	var b strings.Builder
+	b.Grow(len(name))
+	for _, r := range name {
+		switch r {
+		case '_', '-', ' ', '.':
+			continue
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
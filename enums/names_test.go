@@ -0,0 +1,39 @@
+package enums
+
+import (
+	"testing"
+)
+
+func TestFoldName(t *testing.T) {
+	for _, input := range []string{"PENDING", "pending", "Pending", "pEnDiNg"} {
+		if got := FoldName(input); got != "pending" {
+			t.Errorf("FoldName(%q) = %q, want %q", input, got, "pending")
+		}
+	}
+	if got := FoldName("in_progress"); got != "in_progress" {
+		t.Errorf("FoldName must keep separators, got %q", got)
+	}
+}
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"InProgress", "inprogress"},
+		{"in_progress", "inprogress"},
+		{"in-progress", "inprogress"},
+		{"IN PROGRESS", "inprogress"},
+		{"In.Progress", "inprogress"},
+		{"", ""},
+		{"ÄNDERUNG", "änderung"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := NormalizeName(tt.input); got != tt.expected {
+				t.Errorf("NormalizeName(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
@@ -3,15 +3,21 @@ package enums
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 )
 
 func MarshalJSON[R comparable, T comparable, E Enum[R, T]](e E, b any) ([]byte, error) {
 	if e.SerdeFormat() == FormatName {
 		return json.Marshal(e.Name())
 	}
+	// String-backed enums serialize their value as a JSON string
+	if str, ok := any(e.Val()).(string); ok {
+		return json.Marshal(str)
+	}
 	bs, err := anyToString(b)
 	if err != nil {
 		return nil, err
@@ -23,15 +29,15 @@ func UnmarshalJSON[R comparable, T comparable, E Enum[R, T]](e E, bs []byte) (*E
 	if e.SerdeFormat() == FormatName {
 		var name string
 		if err := json.Unmarshal(bs, &name); err != nil {
-			return nil, err
+			return nil, invalidValue[E](string(bs), FormatName, err)
 		}
-		return findNameOrValue(e, name, true, string(bs))
+		return findNameOrValue(e, name, true)
 	}
 	var rawValue R
 	if err := json.Unmarshal(bs, &rawValue); err != nil {
-		return nil, err
+		return nil, invalidValue[E](string(bs), FormatValue, err)
 	}
-	return findNameOrValue(e, rawValue, false, string(bs))
+	return findNameOrValue(e, rawValue, false)
 }
 
 func SQLValue[R comparable, T comparable, E Enum[R, T]](e E) (driver.Value, error) {
@@ -81,17 +87,17 @@ func SQLScan[R comparable, T comparable, E Enum[R, T]](e E, src any) (*E, error)
 		var name string
 		err := NewScanner(&name).Scan(src)
 		if err != nil {
-			return nil, err
+			return nil, invalidValue[E](src, FormatName, err)
 		}
-		return findNameOrValue(e, name, true, src)
+		return findNameOrValue(e, name, true)
 	}
 
 	var rawValue R
 	err := NewScanner(&rawValue).Scan(src)
 	if err != nil {
-		return nil, err
+		return nil, invalidValue[E](src, FormatValue, err)
 	}
-	return findNameOrValue(e, rawValue, false, src)
+	return findNameOrValue(e, rawValue, false)
 }
 
 func MarshalText[R comparable, T comparable, E Enum[R, T]](e E, b any) ([]byte, error) {
@@ -108,15 +114,15 @@ func MarshalText[R comparable, T comparable, E Enum[R, T]](e E, b any) ([]byte,
 func UnmarshalText[R comparable, T comparable, E Enum[R, T]](e E, bs []byte) (*E, error) {
 	str := string(bs)
 	if e.SerdeFormat() == FormatName {
-		return findNameOrValue(e, str, true, str)
+		return findNameOrValue(e, str, true)
 	}
 
 	var rawValue R
 	err := parseStringValue(str, &rawValue)
 	if err != nil {
-		return nil, err
+		return nil, invalidValue[E](str, FormatValue, err)
 	}
-	return findNameOrValue(e, rawValue, false, string(bs))
+	return findNameOrValue(e, rawValue, false)
 }
 
 func MarshalBinary[R comparable, T comparable, E Enum[R, T]](e E, b any) ([]byte, error) {
@@ -129,18 +135,74 @@ func MarshalBinary[R comparable, T comparable, E Enum[R, T]](e E, b any) ([]byte
 func UnmarshalBinary[R comparable, T comparable, E Enum[R, T]](e E, bs []byte) (*E, error) {
 	if e.SerdeFormat() == FormatName {
 		name := string(bs)
-		return findNameOrValue(e, name, true, string(bs))
+		return findNameOrValue(e, name, true)
 	}
 
 	var rawValue R
 	err := parseBinaryValue(bs, &rawValue)
 	if err != nil {
-		return nil, err
+		return nil, invalidValue[E](bs, FormatValue, err)
+	}
+	return findNameOrValue(e, rawValue, false)
+}
+
+// AppendText appends the text form of e to dst, the same bytes MarshalText
+// returns, without reflection for the basic base types.
+func AppendText[R comparable, T comparable, E Enum[R, T]](e E, dst []byte) ([]byte, error) {
+	if e.SerdeFormat() == FormatName {
+		return append(dst, e.Name()...), nil
+	}
+	return appendScalarText(dst, e.Val())
+}
+
+// AppendBinary appends the binary form of e to dst, the same bytes
+// MarshalBinary returns, without reflection for the basic base types.
+func AppendBinary[R comparable, T comparable, E Enum[R, T]](e E, dst []byte) ([]byte, error) {
+	if e.SerdeFormat() == FormatName {
+		return append(dst, e.Name()...), nil
 	}
-	return findNameOrValue(e, rawValue, false, string(bs))
+	return appendScalarBinary(dst, e.Val())
 }
 
-func findNameOrValue[R comparable, T comparable, E Enum[R, T], V any](e E, value V, isName bool, src any) (*E, error) {
+// MarshalXML encodes e as the character data of the element start, formatted
+// as by MarshalText.
+func MarshalXML[R comparable, T comparable, E Enum[R, T]](e E, b any, enc *xml.Encoder, start xml.StartElement) error {
+	text, err := MarshalText(e, b)
+	if err != nil {
+		return err
+	}
+	return enc.EncodeElement(string(text), start)
+}
+
+// UnmarshalXML decodes the character data of the element start as by
+// UnmarshalText. Surrounding whitespace is ignored.
+func UnmarshalXML[R comparable, T comparable, E Enum[R, T]](e E, d *xml.Decoder, start xml.StartElement) (*E, error) {
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return nil, invalidValue[E](nil, e.SerdeFormat(), err)
+	}
+	return UnmarshalText(e, []byte(strings.TrimSpace(text)))
+}
+
+// MarshalXMLAttr encodes e as an attribute called name, formatted as by
+// MarshalText.
+func MarshalXMLAttr[R comparable, T comparable, E Enum[R, T]](e E, b any, name xml.Name) (xml.Attr, error) {
+	text, err := MarshalText(e, b)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// UnmarshalXMLAttr decodes the value of attr as by UnmarshalText.
+func UnmarshalXMLAttr[R comparable, T comparable, E Enum[R, T]](e E, attr xml.Attr) (*E, error) {
+	return UnmarshalText(e, []byte(attr.Value))
+}
+
+// findNameOrValue looks up a decoded name or value. If there is no matching
+// valid enum value, it returns the fallback value of enums implementing
+// FallbackEnum and an *UnknownValueError otherwise.
+func findNameOrValue[R comparable, T comparable, E Enum[R, T], V any](e E, value V, isName bool) (*E, error) {
 	if isName {
 		ret, ok := e.FromName(any(value).(string))
 		if ok {
@@ -148,7 +210,7 @@ func findNameOrValue[R comparable, T comparable, E Enum[R, T], V any](e E, value
 				return &en, nil
 			}
 		}
-		return nil, fmt.Errorf("unknown constants %v", src)
+		return fallback(e, value, FormatName)
 	}
 	ret, ok := e.FromValue(any(value).(R))
 	if ok {
@@ -156,7 +218,17 @@ func findNameOrValue[R comparable, T comparable, E Enum[R, T], V any](e E, value
 			return &en, nil
 		}
 	}
-	return nil, fmt.Errorf("unknown constants %v", src)
+	return fallback(e, value, FormatValue)
+}
+
+// fallback returns the fallback value for an unknown input, or an
+// *UnknownValueError if e has none.
+func fallback[E any](e E, input any, format Format) (*E, error) {
+	if f, ok := any(e).(FallbackEnum[E]); ok {
+		en := f.Fallback(input, format)
+		return &en, nil
+	}
+	return nil, unknownValue[E](input, format)
 }
 
 // YAMLNode represents a YAML node interface to avoid importing yaml package directly
@@ -218,9 +290,9 @@ func UnmarshalYAML[R comparable, T comparable, E Enum[R, T]](e E, node YAMLNode)
 	if e.SerdeFormat() == FormatName {
 		var name string
 		if err := node.Decode(&name); err != nil {
-			return nil, fmt.Errorf("failed to decode YAML node as string: %w", err)
+			return nil, invalidValue[E](node, FormatName, fmt.Errorf("failed to decode YAML node as string: %w", err))
 		}
-		return findNameOrValue(e, name, true, name)
+		return findNameOrValue(e, name, true)
 	}
 
 	// For value format, try to decode as the raw value type
@@ -229,14 +301,14 @@ func UnmarshalYAML[R comparable, T comparable, E Enum[R, T]](e E, node YAMLNode)
 		// If direct decoding fails, try to decode as interface{} and convert
 		var value interface{}
 		if err2 := node.Decode(&value); err2 != nil {
-			return nil, fmt.Errorf("failed to decode YAML node: %w", err)
+			return nil, invalidValue[E](node, FormatValue, fmt.Errorf("failed to decode YAML node: %w", err))
 		}
 
 		// Convert the decoded value to the target type
 		if err := convertToTargetType(value, &rawValue); err != nil {
-			return nil, fmt.Errorf("failed to convert YAML value to target type: %w", err)
+			return nil, invalidValue[E](value, FormatValue, fmt.Errorf("failed to convert YAML value to target type: %w", err))
 		}
 	}
 
-	return findNameOrValue(e, rawValue, false, rawValue)
+	return findNameOrValue(e, rawValue, false)
 }
@@ -1,7 +1,10 @@
 package enums
 
 import (
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -473,3 +476,274 @@ func (m MockEnum) All() []MockEnum                              { return []MockE
 //		t.Logf("MockYAMLNode 解码测试通过: %s", decoded)
 //	})
 //}
+
+// 测试字符串枚举按值序列化
+func TestStringEnumSerialization(t *testing.T) {
+	green := testColor{"green"}
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := MarshalJSON(green, green.val)
+		if err != nil {
+			t.Fatalf("MarshalJSON failed: %v", err)
+		}
+		if string(data) != `"green"` {
+			t.Errorf("expected %q, got %q", `"green"`, data)
+		}
+		result, err := UnmarshalJSON(testColor{}, data)
+		if err != nil {
+			t.Fatalf("UnmarshalJSON failed: %v", err)
+		}
+		if *result != green {
+			t.Errorf("expected %v, got %v", green, *result)
+		}
+	})
+
+	t.Run("JSON按名称", func(t *testing.T) {
+		data, err := MarshalJSON(testColorByName{green}, green.val)
+		if err != nil {
+			t.Fatalf("MarshalJSON failed: %v", err)
+		}
+		if string(data) != `"Green"` {
+			t.Errorf("expected %q, got %q", `"Green"`, data)
+		}
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		value, err := SQLValue(green)
+		if err != nil {
+			t.Fatalf("SQLValue failed: %v", err)
+		}
+		if value != "green" {
+			t.Errorf("expected %q, got %v", "green", value)
+		}
+		result, err := SQLScan(testColor{}, []byte("green"))
+		if err != nil {
+			t.Fatalf("SQLScan failed: %v", err)
+		}
+		if *result != green {
+			t.Errorf("expected %v, got %v", green, *result)
+		}
+	})
+
+	t.Run("YAML", func(t *testing.T) {
+		value, err := MarshalYAML(green, green.val)
+		if err != nil {
+			t.Fatalf("MarshalYAML failed: %v", err)
+		}
+		if value != "green" {
+			t.Errorf("expected %q, got %v", "green", value)
+		}
+		result, err := UnmarshalYAML(testColor{}, &MockYAMLNode{value: "green"})
+		if err != nil {
+			t.Fatalf("UnmarshalYAML failed: %v", err)
+		}
+		if *result != green {
+			t.Errorf("expected %v, got %v", green, *result)
+		}
+	})
+
+	t.Run("未知值", func(t *testing.T) {
+		if _, err := UnmarshalJSON(testColor{}, []byte(`"purple"`)); err == nil {
+			t.Error("UnmarshalJSON should fail for unknown value")
+		}
+	})
+}
+
+func TestFallback(t *testing.T) {
+	t.Run("未知值保留原值", func(t *testing.T) {
+		for _, decode := range []func() (*testOpenStatus, error){
+			func() (*testOpenStatus, error) { return UnmarshalJSON(testOpenStatus{}, []byte(`9`)) },
+			func() (*testOpenStatus, error) { return UnmarshalText(testOpenStatus{}, []byte("9")) },
+			func() (*testOpenStatus, error) { return SQLScan(testOpenStatus{}, int64(9)) },
+			func() (*testOpenStatus, error) { return UnmarshalYAML(testOpenStatus{}, &MockYAMLNode{value: 9}) },
+		} {
+			v, err := decode()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if v.Val() != 9 {
+				t.Errorf("Val() = %d, want 9", v.Val())
+			}
+			data, err := MarshalJSON(*v, v.Val())
+			if err != nil || string(data) != "9" {
+				t.Errorf("MarshalJSON = %s, %v, want 9", data, err)
+			}
+		}
+	})
+
+	t.Run("已知值不受影响", func(t *testing.T) {
+		v, err := UnmarshalJSON(testOpenStatus{}, []byte(`2`))
+		if err != nil || v.Name() != "Active" {
+			t.Errorf("UnmarshalJSON(2) = %v, %v, want Active", v, err)
+		}
+	})
+
+	t.Run("无法解码的输入仍然报错", func(t *testing.T) {
+		_, err := UnmarshalJSON(testOpenStatus{}, []byte(`"Active"`))
+		if !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("errors.Is(%v, ErrInvalidValue) = false", err)
+		}
+	})
+}
+
+// xmlStatus 通过 XML 辅助函数实现 encoding/xml 的接口，与生成的代码相同
+type xmlStatus struct{ testStatus }
+
+func (s xmlStatus) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(s.testStatus, s.Val(), e, start)
+}
+
+func (s *xmlStatus) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	result, err := UnmarshalXML(s.testStatus, d, start)
+	if err != nil {
+		return err
+	}
+	s.testStatus = *result
+	return nil
+}
+
+func (s xmlStatus) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(s.testStatus, s.Val(), name)
+}
+
+func (s *xmlStatus) UnmarshalXMLAttr(attr xml.Attr) error {
+	result, err := UnmarshalXMLAttr(s.testStatus, attr)
+	if err != nil {
+		return err
+	}
+	s.testStatus = *result
+	return nil
+}
+
+func TestXML(t *testing.T) {
+	type order struct {
+		XMLName  xml.Name  `xml:"order"`
+		Status   xmlStatus `xml:"status,attr"`
+		Previous xmlStatus `xml:"previous"`
+	}
+
+	t.Run("元素和属性", func(t *testing.T) {
+		in := order{Status: xmlStatus{statusActive}, Previous: xmlStatus{statusPending}}
+		data, err := xml.Marshal(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `<order status="2"><previous>1</previous></order>`
+		if string(data) != want {
+			t.Errorf("Marshal = %s, want %s", data, want)
+		}
+
+		var out order
+		if err := xml.Unmarshal([]byte(`<order status="2"><previous> 1 </previous></order>`), &out); err != nil {
+			t.Fatal(err)
+		}
+		if out.Status.testStatus != statusActive || out.Previous.testStatus != statusPending {
+			t.Errorf("Unmarshal = %+v", out)
+		}
+	})
+
+	t.Run("按名称序列化", func(t *testing.T) {
+		var buf strings.Builder
+		enc := xml.NewEncoder(&buf)
+		red := testColorByName{testColor{"red"}}
+		if err := MarshalXML(red, red.Val(), enc, xml.StartElement{Name: xml.Name{Local: "color"}}); err != nil {
+			t.Fatal(err)
+		}
+		if err := enc.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := buf.String(), "<color>Red</color>"; got != want {
+			t.Errorf("MarshalXML = %s, want %s", got, want)
+		}
+
+		attr, err := MarshalXMLAttr(red, red.Val(), xml.Name{Local: "color"})
+		if err != nil || attr.Value != "Red" {
+			t.Errorf("MarshalXMLAttr = %v, %v, want Red", attr, err)
+		}
+		v, err := UnmarshalXMLAttr(testColorByName{}, xml.Attr{Value: "Blue"})
+		if err != nil || v.Val() != "blue" {
+			t.Errorf("UnmarshalXMLAttr(Blue) = %v, %v", v, err)
+		}
+	})
+
+	t.Run("未知值", func(t *testing.T) {
+		var out order
+		err := xml.Unmarshal([]byte(`<order status="9"><previous>1</previous></order>`), &out)
+		if !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("errors.Is(%v, ErrUnknownValue) = false", err)
+		}
+		err = xml.Unmarshal([]byte(`<order status="1"><previous>x</previous></order>`), &out)
+		if !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("errors.Is(%v, ErrInvalidValue) = false", err)
+		}
+	})
+}
+
+func TestAppendScalar(t *testing.T) {
+	// 与基于反射的实现结果一致
+	values := []any{
+		int(-42), int8(-8), int16(1234), int32(-70000), int64(1 << 40),
+		uint(42), uint8(200), uint16(65535), uint32(1 << 31), uint64(1 << 63),
+		float32(0.1), float64(3.14159), float64(1e21), true, "hello",
+	}
+	for _, v := range values {
+		wantText, _ := anyToString(v)
+		gotText, err := appendScalarText([]byte("x"), v)
+		if err != nil || string(gotText) != "x"+wantText {
+			t.Errorf("appendScalarText(%T %v) = %q, %v, want %q", v, v, gotText, err, "x"+wantText)
+		}
+		wantBinary, _ := anyToBinary(v)
+		gotBinary, err := appendScalarBinary([]byte("x"), v)
+		if err != nil || string(gotBinary) != "x"+string(wantBinary) {
+			t.Errorf("appendScalarBinary(%T %v) = % x, %v, want x% x", v, v, gotBinary, err, wantBinary)
+		}
+	}
+}
+
+func TestAppendText(t *testing.T) {
+	red := testColorByName{testColor{"red"}}
+	cases := []struct {
+		name   string
+		append func([]byte) ([]byte, error)
+		want   func() ([]byte, error)
+	}{
+		{"值文本", func(b []byte) ([]byte, error) { return AppendText(statusActive, b) },
+			func() ([]byte, error) { return MarshalText(statusActive, statusActive.Val()) }},
+		{"名称文本", func(b []byte) ([]byte, error) { return AppendText(red, b) },
+			func() ([]byte, error) { return MarshalText(red, red.Val()) }},
+		{"值二进制", func(b []byte) ([]byte, error) { return AppendBinary(statusDone, b) },
+			func() ([]byte, error) { return MarshalBinary(statusDone, statusDone.Val()) }},
+		{"名称二进制", func(b []byte) ([]byte, error) { return AppendBinary(red, b) },
+			func() ([]byte, error) { return MarshalBinary(red, red.Val()) }},
+		{"值msgpack", func(b []byte) ([]byte, error) { return AppendMsgpack(testStatus{1 << 20}, b) },
+			func() ([]byte, error) { return MarshalMsgpack(testStatus{1 << 20}, 1<<20) }},
+		{"名称msgpack", func(b []byte) ([]byte, error) { return AppendMsgpack(red, b) },
+			func() ([]byte, error) { return MarshalMsgpack(red, red.Val()) }},
+		{"值CBOR", func(b []byte) ([]byte, error) { return AppendCBOR(testColor{"red"}, b) },
+			func() ([]byte, error) { return MarshalCBOR(testColor{"red"}, "red") }},
+		{"名称CBOR", func(b []byte) ([]byte, error) { return AppendCBOR(red, b) },
+			func() ([]byte, error) { return MarshalCBOR(red, red.Val()) }},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			want, _ := tc.want()
+			got, err := tc.append([]byte("prefix:"))
+			if err != nil || string(got) != "prefix:"+string(want) {
+				t.Errorf("got %q, %v, want %q", got, err, "prefix:"+string(want))
+			}
+		})
+	}
+
+	// 较大的值在转换为接口时也不应分配内存
+	large := testStatus{1 << 20}
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = AppendText(large, buf[:0])
+		buf, _ = AppendBinary(large, buf)
+		buf, _ = AppendMsgpack(large, buf)
+		buf, _ = AppendCBOR(large, buf)
+	})
+	if allocs != 0 {
+		t.Errorf("Append functions allocate %v times, want 0", allocs)
+	}
+}
@@ -0,0 +1,310 @@
+package enums
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"math/bits"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Element is the constraint for enums stored in a Set or Map.
+type Element[R comparable, E comparable] interface {
+	comparable
+	Enum[R, E]
+}
+
+// ordinalIndex maps the values of an enum to their position in All().
+type ordinalIndex[E comparable] struct {
+	values []E
+	index  map[E]int
+}
+
+var ordinalCache sync.Map // reflect.Type -> *ordinalIndex[E]
+
+// ordinals returns the declaration order of the values of E, computed once per type.
+func ordinals[R comparable, E Element[R, E]]() *ordinalIndex[E] {
+	key := reflect.TypeFor[E]()
+	if idx, ok := ordinalCache.Load(key); ok {
+		return idx.(*ordinalIndex[E])
+	}
+	var zero E
+	idx := &ordinalIndex[E]{index: make(map[E]int)}
+	for v := range zero.All() {
+		if _, ok := idx.index[v]; ok {
+			continue
+		}
+		idx.index[v] = len(idx.values)
+		idx.values = append(idx.values, v)
+	}
+	actual, _ := ordinalCache.LoadOrStore(key, idx)
+	return actual.(*ordinalIndex[E])
+}
+
+// Set is a set of enum values backed by a bitmap indexed by declaration order.
+// Iteration follows the declaration order of the enum. Values that are not
+// returned by All(), such as invalid values, cannot be stored and are ignored.
+//
+// The zero value is an empty set. Copies of a set share storage, so use
+// Clone before modifying a copy independently.
+type Set[R comparable, E Element[R, E]] struct {
+	bits []uint64
+}
+
+// NewSet returns a set containing the given values.
+func NewSet[R comparable, E Element[R, E]](values ...E) Set[R, E] {
+	var s Set[R, E]
+	s.Add(values...)
+	return s
+}
+
+// Add inserts the given values into the set.
+func (s *Set[R, E]) Add(values ...E) {
+	idx := ordinals[R, E]()
+	for _, v := range values {
+		i, ok := idx.index[v]
+		if !ok {
+			continue
+		}
+		for len(s.bits) <= i/64 {
+			s.bits = append(s.bits, 0)
+		}
+		s.bits[i/64] |= 1 << (i % 64)
+	}
+}
+
+// Remove deletes the given values from the set.
+func (s *Set[R, E]) Remove(values ...E) {
+	idx := ordinals[R, E]()
+	for _, v := range values {
+		if i, ok := idx.index[v]; ok && i/64 < len(s.bits) {
+			s.bits[i/64] &^= 1 << (i % 64)
+		}
+	}
+}
+
+// Contains returns true if v is in the set.
+func (s Set[R, E]) Contains(v E) bool {
+	i, ok := ordinals[R, E]().index[v]
+	return ok && i/64 < len(s.bits) && s.bits[i/64]&(1<<(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s Set[R, E]) Len() int {
+	n := 0
+	for _, word := range s.bits {
+		n += bits.OnesCount64(word)
+	}
+	return n
+}
+
+// IsEmpty returns true if the set contains no values.
+func (s Set[R, E]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// Clear removes all values from the set.
+func (s *Set[R, E]) Clear() {
+	clear(s.bits)
+}
+
+// Values returns the values of the set in declaration order.
+func (s Set[R, E]) Values() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		values := ordinals[R, E]().values
+		for w, word := range s.bits {
+			for word != 0 {
+				i := w*64 + bits.TrailingZeros64(word)
+				word &= word - 1
+				if !yield(values[i]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Slice returns the values of the set in declaration order.
+func (s Set[R, E]) Slice() []E {
+	result := make([]E, 0, s.Len())
+	for v := range s.Values() {
+		result = append(result, v)
+	}
+	return result
+}
+
+// Clone returns an independent copy of the set.
+func (s Set[R, E]) Clone() Set[R, E] {
+	return Set[R, E]{bits: append([]uint64(nil), s.bits...)}
+}
+
+// Equal returns true if both sets contain the same values.
+func (s Set[R, E]) Equal(other Set[R, E]) bool {
+	for i := range max(len(s.bits), len(other.bits)) {
+		if s.word(i) != other.word(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// Union returns a new set with the values that are in s or other.
+func (s Set[R, E]) Union(other Set[R, E]) Set[R, E] {
+	return s.combine(other, func(a, b uint64) uint64 { return a | b })
+}
+
+// Intersect returns a new set with the values that are in both s and other.
+func (s Set[R, E]) Intersect(other Set[R, E]) Set[R, E] {
+	return s.combine(other, func(a, b uint64) uint64 { return a & b })
+}
+
+// Difference returns a new set with the values of s that are not in other.
+func (s Set[R, E]) Difference(other Set[R, E]) Set[R, E] {
+	return s.combine(other, func(a, b uint64) uint64 { return a &^ b })
+}
+
+// Complement returns a new set with every value of All() that is not in s.
+func (s Set[R, E]) Complement() Set[R, E] {
+	result := Set[R, E]{}
+	for i, v := range ordinals[R, E]().values {
+		if !s.Contains(v) {
+			for len(result.bits) <= i/64 {
+				result.bits = append(result.bits, 0)
+			}
+			result.bits[i/64] |= 1 << (i % 64)
+		}
+	}
+	return result
+}
+
+func (s Set[R, E]) word(i int) uint64 {
+	if i < len(s.bits) {
+		return s.bits[i]
+	}
+	return 0
+}
+
+func (s Set[R, E]) combine(other Set[R, E], op func(a, b uint64) uint64) Set[R, E] {
+	result := Set[R, E]{bits: make([]uint64, max(len(s.bits), len(other.bits)))}
+	for i := range result.bits {
+		result.bits[i] = op(s.word(i), other.word(i))
+	}
+	return result
+}
+
+// String returns the names of the values, e.g. "[Pending Active]".
+func (s Set[R, E]) String() string {
+	names := make([]string, 0, s.Len())
+	for v := range s.Values() {
+		names = append(names, v.Name())
+	}
+	return "[" + strings.Join(names, " ") + "]"
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Each value is serialized according to its SerdeFormat.
+func (s Set[R, E]) MarshalJSON() ([]byte, error) {
+	items := make([]json.RawMessage, 0, s.Len())
+	for v := range s.Values() {
+		data, err := MarshalJSON(v, v.Val())
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, data)
+	}
+	return json.Marshal(items)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *Set[R, E]) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	var zero E
+	result := Set[R, E]{}
+	for _, item := range items {
+		v, err := UnmarshalJSON(zero, item)
+		if err != nil {
+			return err
+		}
+		result.Add(*v)
+	}
+	*s = result
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (s Set[R, E]) MarshalYAML() (any, error) {
+	items := make([]any, 0, s.Len())
+	for v := range s.Values() {
+		item, err := MarshalYAML(v, v.Val())
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// UnmarshalYAML implements the legacy yaml.Unmarshaler interface, which is
+// supported by both gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (s *Set[R, E]) UnmarshalYAML(unmarshal func(any) error) error {
+	var zero E
+	result := Set[R, E]{}
+	if zero.SerdeFormat() == FormatName {
+		var names []string
+		if err := unmarshal(&names); err != nil {
+			return err
+		}
+		for _, name := range names {
+			v, err := findNameOrValue(zero, name, true)
+			if err != nil {
+				return err
+			}
+			result.Add(*v)
+		}
+	} else {
+		var values []R
+		if err := unmarshal(&values); err != nil {
+			return err
+		}
+		for _, value := range values {
+			v, err := findNameOrValue(zero, value, false)
+			if err != nil {
+				return err
+			}
+			result.Add(*v)
+		}
+	}
+	*s = result
+	return nil
+}
+
+// Value implements the driver.Valuer interface. The set is stored as a JSON array.
+func (s Set[R, E]) Value() (driver.Value, error) {
+	data, err := s.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for JSON columns.
+func (s *Set[R, E]) Scan(src any) error {
+	if src == nil {
+		*s = Set[R, E]{}
+		return nil
+	}
+	switch data := src.(type) {
+	case []byte:
+		return s.UnmarshalJSON(data)
+	case string:
+		return s.UnmarshalJSON([]byte(data))
+	default:
+		return fmt.Errorf("cannot scan %T into set", src)
+	}
+}
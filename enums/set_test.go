@@ -0,0 +1,161 @@
+package enums
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var (
+	statusPending = testStatus{1}
+	statusActive  = testStatus{2}
+	statusDone    = testStatus{3}
+)
+
+func TestSet(t *testing.T) {
+	s := NewSet(statusDone, statusPending)
+
+	if !s.Contains(statusPending) || !s.Contains(statusDone) || s.Contains(statusActive) {
+		t.Errorf("unexpected contents: %v", s)
+	}
+	if s.Len() != 2 {
+		t.Errorf("expected 2 values, got %d", s.Len())
+	}
+
+	// 迭代顺序与声明顺序一致
+	got := s.Slice()
+	if len(got) != 2 || got[0] != statusPending || got[1] != statusDone {
+		t.Errorf("expected declaration order, got %v", got)
+	}
+	if s.String() != "[Pending Done]" {
+		t.Errorf("unexpected String(): %s", s.String())
+	}
+
+	s.Remove(statusPending)
+	if s.Contains(statusPending) || s.Len() != 1 {
+		t.Errorf("Remove failed: %v", s)
+	}
+
+	// 无效值被忽略
+	s.Add(testStatus{99})
+	if s.Len() != 1 {
+		t.Errorf("invalid value should be ignored, got %v", s)
+	}
+
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Errorf("Clear failed: %v", s)
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	a := NewSet(statusPending, statusActive)
+	b := NewSet(statusActive, statusDone)
+
+	tests := []struct {
+		name     string
+		result   Set[int, testStatus]
+		expected Set[int, testStatus]
+	}{
+		{"union", a.Union(b), NewSet(statusPending, statusActive, statusDone)},
+		{"intersect", a.Intersect(b), NewSet(statusActive)},
+		{"difference", a.Difference(b), NewSet(statusPending)},
+		{"complement", a.Complement(), NewSet(statusDone)},
+		{"complement of empty", Set[int, testStatus]{}.Complement(), NewSet(statusPending, statusActive, statusDone)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.result.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, tt.result)
+			}
+		})
+	}
+
+	t.Run("clone", func(t *testing.T) {
+		c := a.Clone()
+		c.Add(statusDone)
+		if a.Contains(statusDone) {
+			t.Error("modifying a clone must not affect the original")
+		}
+	})
+}
+
+func TestSetSerialization(t *testing.T) {
+	t.Run("JSON按值", func(t *testing.T) {
+		s := NewSet(statusDone, statusPending)
+		data, err := json.Marshal(s)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		if string(data) != `[1,3]` {
+			t.Errorf("expected [1,3], got %s", data)
+		}
+		var back Set[int, testStatus]
+		if err := json.Unmarshal(data, &back); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if !back.Equal(s) {
+			t.Errorf("expected %v, got %v", s, back)
+		}
+	})
+
+	t.Run("JSON按名称", func(t *testing.T) {
+		s := NewSet(testColorByName{testColor{"blue"}}, testColorByName{testColor{"red"}})
+		data, err := json.Marshal(s)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		if string(data) != `["Red","Blue"]` {
+			t.Errorf(`expected ["Red","Blue"], got %s`, data)
+		}
+		var back Set[string, testColorByName]
+		if err := json.Unmarshal(data, &back); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if !back.Equal(s) {
+			t.Errorf("expected %v, got %v", s, back)
+		}
+	})
+
+	t.Run("JSON未知值", func(t *testing.T) {
+		var s Set[int, testStatus]
+		if err := json.Unmarshal([]byte(`[1,42]`), &s); err == nil {
+			t.Error("Unmarshal should fail for unknown value")
+		}
+	})
+
+	t.Run("YAML", func(t *testing.T) {
+		s := NewSet(statusActive)
+		value, err := s.MarshalYAML()
+		if err != nil {
+			t.Fatalf("MarshalYAML failed: %v", err)
+		}
+		items, ok := value.([]any)
+		if !ok || len(items) != 1 || items[0] != int64(2) {
+			t.Errorf("unexpected MarshalYAML result: %#v", value)
+		}
+		var back Set[int, testStatus]
+		err = back.UnmarshalYAML(func(v any) error {
+			*v.(*[]int) = []int{2}
+			return nil
+		})
+		if err != nil || !back.Equal(s) {
+			t.Errorf("UnmarshalYAML = %v, %v", back, err)
+		}
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		s := NewSet(statusPending, statusActive)
+		value, err := s.Value()
+		if err != nil {
+			t.Fatalf("Value failed: %v", err)
+		}
+		if value != `[1,2]` {
+			t.Errorf("expected [1,2], got %v", value)
+		}
+		var back Set[int, testStatus]
+		if err := back.Scan([]byte(`[1,2]`)); err != nil || !back.Equal(s) {
+			t.Errorf("Scan = %v, %v", back, err)
+		}
+	})
+}
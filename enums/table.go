@@ -0,0 +1,38 @@
+package enums
+
+// TransitionTable describes the valid values of an enum and the transitions
+// between them. It is meant to be exported as JSON for other languages.
+type TransitionTable struct {
+	Type   string       `json:"type"`
+	States []StateEntry `json:"states"`
+}
+
+// StateEntry describes a single state of a TransitionTable. States are
+// referred to by their name.
+type StateEntry struct {
+	Name        string       `json:"name"`
+	Aliases     []string     `json:"aliases,omitempty"`
+	Value       any          `json:"value"`
+	Tags        []string     `json:"tags,omitempty"`
+	Parent      string       `json:"parent,omitempty"`
+	Initial     bool         `json:"initial"`
+	Final       bool         `json:"final"`
+	Transitions []string     `json:"transitions"`
+	Events      []EventEntry `json:"events,omitempty"`
+}
+
+// EventEntry is a transition triggered by a named event.
+type EventEntry struct {
+	Event  string `json:"event"`
+	Target string `json:"target"`
+}
+
+// State returns the entry of the state called name.
+func (t TransitionTable) State(name string) (StateEntry, bool) {
+	for _, s := range t.States {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return StateEntry{}, false
+}
@@ -0,0 +1,34 @@
+package enums
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTransitionTableJSON(t *testing.T) {
+	table := TransitionTable{
+		Type: "OrderStatus",
+		States: []StateEntry{
+			{Name: "Pending", Value: 1, Initial: true, Transitions: []string{"Done"},
+				Events: []EventEntry{{Event: "finish", Target: "Done"}}},
+			{Name: "Done", Aliases: []string{"Finished"}, Value: 2, Tags: []string{"terminal"}, Final: true, Transitions: []string{}},
+		},
+	}
+	data, err := json.Marshal(table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"type":"OrderStatus","states":[` +
+		`{"name":"Pending","value":1,"initial":true,"final":false,"transitions":["Done"],"events":[{"event":"finish","target":"Done"}]},` +
+		`{"name":"Done","aliases":["Finished"],"value":2,"tags":["terminal"],"initial":false,"final":true,"transitions":[]}]}`
+	if string(data) != want {
+		t.Errorf("json.Marshal =\n%s\nwant\n%s", data, want)
+	}
+
+	if s, ok := table.State("Done"); !ok || !s.Final {
+		t.Errorf("State(Done) = %v, %v", s, ok)
+	}
+	if _, ok := table.State("Finished"); ok {
+		t.Error("State(Finished) found an alias")
+	}
+}
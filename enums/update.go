@@ -0,0 +1,91 @@
+package enums
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+)
+
+// Execer is implemented by *sql.DB, *sql.Tx and *sql.Conn.
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// Placeholder is the bind parameter syntax of a database driver.
+type Placeholder int
+
+const (
+	QuestionPlaceholder Placeholder = iota // ?, used by MySQL and SQLite
+	DollarPlaceholder                      // $1, used by PostgreSQL
+)
+
+// StateUpdate describes the column a state machine enum is stored in. The
+// names are inserted into the statement verbatim, so they must be trusted
+// identifiers, quoted if necessary.
+type StateUpdate struct {
+	Table       string
+	Column      string // Column holding the state
+	IDColumn    string // Column identifying the row
+	Placeholder Placeholder
+}
+
+// Query returns the statement that sets the state of the row identified by
+// id to `to`, but only if its current state is one of from:
+//
+//	UPDATE table SET column = $1 WHERE id_column = $2 AND column IN ($3, $4)
+func (u StateUpdate) Query(id any, to any, from []any) (string, []any) {
+	args := make([]any, 0, len(from)+2)
+	param := func(arg any) string {
+		args = append(args, arg)
+		if u.Placeholder == DollarPlaceholder {
+			return "$" + strconv.Itoa(len(args))
+		}
+		return "?"
+	}
+
+	var b strings.Builder
+	b.WriteString("UPDATE " + u.Table + " SET " + u.Column + " = " + param(to))
+	b.WriteString(" WHERE " + u.IDColumn + " = " + param(id))
+	b.WriteString(" AND " + u.Column + " IN (")
+	for i, f := range from {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(param(f))
+	}
+	b.WriteString(")")
+	return b.String(), args
+}
+
+// UpdateState moves the row identified by id to state `to` with a single
+// conditional UPDATE, so that concurrent writers cannot perform a transition
+// the state machine does not allow. from are the states that may transition
+// to `to`, and states are stored as by SQLValue. It returns false if no row
+// was updated, because the row does not exist or its current state is not
+// one of from.
+func UpdateState[R comparable, E Element[R, E]](ctx context.Context, db Execer, u StateUpdate, id any, to E, from []E) (bool, error) {
+	if len(from) == 0 {
+		return false, nil
+	}
+	target, err := SQLValue(to)
+	if err != nil {
+		return false, err
+	}
+	sources := make([]any, len(from))
+	for i, f := range from {
+		if sources[i], err = SQLValue(f); err != nil {
+			return false, err
+		}
+	}
+	query, args := u.Query(id, target, sources)
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,107 @@
+package enums
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// fakeStateDriver 模拟一个只有 id 和 state 两列的表
+type fakeStateDriver struct {
+	mu      sync.Mutex
+	rows    map[int64]int64
+	queries []string
+}
+
+func (d *fakeStateDriver) Open(string) (driver.Conn, error) { return fakeStateConn{d}, nil }
+
+type fakeStateConn struct{ d *fakeStateDriver }
+
+func (c fakeStateConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c fakeStateConn) Close() error                        { return nil }
+func (c fakeStateConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+// ExecContext 执行 UPDATE: 参数依次为新状态、id 和允许的旧状态
+func (c fakeStateConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	c.d.queries = append(c.d.queries, query)
+	to, id := args[0].Value.(int64), args[1].Value.(int64)
+	current, ok := c.d.rows[id]
+	if !ok {
+		return driver.RowsAffected(0), nil
+	}
+	for _, from := range args[2:] {
+		if from.Value.(int64) == current {
+			c.d.rows[id] = to
+			return driver.RowsAffected(1), nil
+		}
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func openFakeStateDB(t *testing.T, rows map[int64]int64) (*sql.DB, *fakeStateDriver) {
+	d := &fakeStateDriver{rows: rows}
+	name := "fakestate-" + t.Name()
+	sql.Register(name, d)
+	db, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db, d
+}
+
+func TestStateUpdateQuery(t *testing.T) {
+	tests := []struct {
+		placeholder Placeholder
+		want        string
+	}{
+		{QuestionPlaceholder, "UPDATE orders SET status = ? WHERE id = ? AND status IN (?, ?)"},
+		{DollarPlaceholder, "UPDATE orders SET status = $1 WHERE id = $2 AND status IN ($3, $4)"},
+	}
+	for _, tt := range tests {
+		u := StateUpdate{Table: "orders", Column: "status", IDColumn: "id", Placeholder: tt.placeholder}
+		query, args := u.Query(7, 3, []any{1, 2})
+		if query != tt.want {
+			t.Errorf("Query() = %q, want %q", query, tt.want)
+		}
+		if !slices.Equal(args, []any{3, 7, 1, 2}) {
+			t.Errorf("args = %v, want [3 7 1 2]", args)
+		}
+	}
+}
+
+func TestUpdateState(t *testing.T) {
+	db, d := openFakeStateDB(t, map[int64]int64{1: 1, 2: 3})
+	u := StateUpdate{Table: "orders", Column: "status", IDColumn: "id", Placeholder: DollarPlaceholder}
+	ctx := context.Background()
+
+	// Pending -> Done 只允许从 Active 开始
+	ok, err := UpdateState(ctx, db, u, int64(1), statusDone, []testStatus{statusActive})
+	if err != nil || ok {
+		t.Errorf("UpdateState(1, Done) = %v, %v, want false", ok, err)
+	}
+	ok, err = UpdateState(ctx, db, u, int64(1), statusActive, []testStatus{statusPending})
+	if err != nil || !ok {
+		t.Errorf("UpdateState(1, Active) = %v, %v, want true", ok, err)
+	}
+	if d.rows[1] != 2 {
+		t.Errorf("row 1 has state %d, want 2", d.rows[1])
+	}
+	ok, err = UpdateState(ctx, db, u, int64(3), statusActive, []testStatus{statusPending})
+	if err != nil || ok {
+		t.Errorf("UpdateState(3, Active) = %v, %v, want false for a missing row", ok, err)
+	}
+
+	// 没有可转换的来源状态时不执行语句
+	queries := len(d.queries)
+	ok, err = UpdateState[int](ctx, db, u, int64(2), statusPending, nil)
+	if err != nil || ok || len(d.queries) != queries {
+		t.Errorf("UpdateState with no sources = %v, %v and executed %d queries", ok, err, len(d.queries)-queries)
+	}
+}
@@ -230,6 +230,37 @@ func parseBinaryValue[T any](data []byte, value *T) error {
 		return fmt.Errorf("empty binary data")
 	}
 
+	// 基本类型无需反射
+	switch v := any(value).(type) {
+	case *int:
+		return readBinary(data, 8, "int64", func(u uint64) { *v = int(u) })
+	case *int8:
+		return readBinary(data, 1, "int8", func(u uint64) { *v = int8(u) })
+	case *int16:
+		return readBinary(data, 2, "int16", func(u uint64) { *v = int16(u) })
+	case *int32:
+		return readBinary(data, 4, "int32", func(u uint64) { *v = int32(u) })
+	case *int64:
+		return readBinary(data, 8, "int64", func(u uint64) { *v = int64(u) })
+	case *uint:
+		return readBinary(data, 8, "uint64", func(u uint64) { *v = uint(u) })
+	case *uint8:
+		return readBinary(data, 1, "uint8", func(u uint64) { *v = uint8(u) })
+	case *uint16:
+		return readBinary(data, 2, "uint16", func(u uint64) { *v = uint16(u) })
+	case *uint32:
+		return readBinary(data, 4, "uint32", func(u uint64) { *v = uint32(u) })
+	case *uint64:
+		return readBinary(data, 8, "uint64", func(u uint64) { *v = u })
+	case *float32:
+		return readBinary(data, 4, "float32", func(u uint64) { *v = math.Float32frombits(uint32(u)) })
+	case *float64:
+		return readBinary(data, 8, "float64", func(u uint64) { *v = math.Float64frombits(u) })
+	case *string:
+		*v = string(data)
+		return nil
+	}
+
 	// 使用反射获取目标类型的信息
 	v := reflect.ValueOf(value).Elem()
 
@@ -309,6 +340,19 @@ func parseBinaryValue[T any](data []byte, value *T) error {
 	return nil
 }
 
+// readBinary 读取 size 字节的大端无符号整数并传给 set
+func readBinary(data []byte, size int, typeName string, set func(uint64)) error {
+	if len(data) < size {
+		return fmt.Errorf("insufficient data for %s", typeName)
+	}
+	var u uint64
+	for _, b := range data[:size] {
+		u = u<<8 | uint64(b)
+	}
+	set(u)
+	return nil
+}
+
 // convertToTargetType converts an interface{} value to the target type
 func convertToTargetType[R comparable](value any, target *R) error {
 	if value == nil {
@@ -381,3 +425,314 @@ func convertToTargetType[R comparable](value any, target *R) error {
 	}
 	return nil
 }
+
+// scalar is the raw value of an enum as written by the msgpack and CBOR
+// encoders.
+type scalar struct {
+	kind reflect.Kind // Int64, Uint64, Float32, Float64 or String
+	i    int64
+	u    uint64
+	f    float64
+	s    string
+}
+
+// scalarOf converts the raw value of an enum to the scalar written by the
+// msgpack and CBOR encoders. Basic types are converted without reflection.
+func scalarOf[R any](value R) (scalar, error) {
+	switch v := any(value).(type) {
+	case int:
+		return scalar{kind: reflect.Int64, i: int64(v)}, nil
+	case int8:
+		return scalar{kind: reflect.Int64, i: int64(v)}, nil
+	case int16:
+		return scalar{kind: reflect.Int64, i: int64(v)}, nil
+	case int32:
+		return scalar{kind: reflect.Int64, i: int64(v)}, nil
+	case int64:
+		return scalar{kind: reflect.Int64, i: v}, nil
+	case uint:
+		return scalar{kind: reflect.Uint64, u: uint64(v)}, nil
+	case uint8:
+		return scalar{kind: reflect.Uint64, u: uint64(v)}, nil
+	case uint16:
+		return scalar{kind: reflect.Uint64, u: uint64(v)}, nil
+	case uint32:
+		return scalar{kind: reflect.Uint64, u: uint64(v)}, nil
+	case uint64:
+		return scalar{kind: reflect.Uint64, u: v}, nil
+	case float32:
+		return scalar{kind: reflect.Float32, f: float64(v)}, nil
+	case float64:
+		return scalar{kind: reflect.Float64, f: v}, nil
+	case string:
+		return scalar{kind: reflect.String, s: v}, nil
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return scalar{kind: reflect.Int64, i: v.Int()}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return scalar{kind: reflect.Uint64, u: v.Uint()}, nil
+	case reflect.Float32:
+		return scalar{kind: reflect.Float32, f: v.Float()}, nil
+	case reflect.Float64:
+		return scalar{kind: reflect.Float64, f: v.Float()}, nil
+	case reflect.String:
+		return scalar{kind: reflect.String, s: v.String()}, nil
+	default:
+		return scalar{}, fmt.Errorf("unsupported type %T", value)
+	}
+}
+
+// setScalar stores a decoded msgpack or CBOR scalar, an int64, uint64,
+// float64 or string, in target. Integers are accepted for float targets, but
+// not the other way around. Basic types are set without reflection.
+func setScalar[R any](value any, target *R) error {
+	switch t := any(target).(type) {
+	case *int:
+		return setInt(value, t)
+	case *int8:
+		return setInt(value, t)
+	case *int16:
+		return setInt(value, t)
+	case *int32:
+		return setInt(value, t)
+	case *int64:
+		return setInt(value, t)
+	case *uint:
+		return setUint(value, t)
+	case *uint8:
+		return setUint(value, t)
+	case *uint16:
+		return setUint(value, t)
+	case *uint32:
+		return setUint(value, t)
+	case *uint64:
+		return setUint(value, t)
+	case *float32:
+		return setFloat(value, t)
+	case *float64:
+		return setFloat(value, t)
+	case *string:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("cannot convert %T to string", value)
+		}
+		*t = s
+		return nil
+	}
+
+	v := reflect.ValueOf(target).Elem()
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		switch x := value.(type) {
+		case int64:
+			n = x
+		case uint64:
+			if x > math.MaxInt64 {
+				return fmt.Errorf("value %d overflows %s", x, v.Type())
+			}
+			n = int64(x)
+		default:
+			return fmt.Errorf("cannot convert %T to %s", value, v.Type())
+		}
+		if v.OverflowInt(n) {
+			return fmt.Errorf("value %d overflows %s", n, v.Type())
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var n uint64
+		switch x := value.(type) {
+		case int64:
+			if x < 0 {
+				return fmt.Errorf("value %d overflows %s", x, v.Type())
+			}
+			n = uint64(x)
+		case uint64:
+			n = x
+		default:
+			return fmt.Errorf("cannot convert %T to %s", value, v.Type())
+		}
+		if v.OverflowUint(n) {
+			return fmt.Errorf("value %d overflows %s", n, v.Type())
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		switch x := value.(type) {
+		case float64:
+			v.SetFloat(x)
+		case int64:
+			v.SetFloat(float64(x))
+		case uint64:
+			v.SetFloat(float64(x))
+		default:
+			return fmt.Errorf("cannot convert %T to %s", value, v.Type())
+		}
+	case reflect.String:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("cannot convert %T to %s", value, v.Type())
+		}
+		v.SetString(s)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+func setInt[T int | int8 | int16 | int32 | int64](value any, target *T) error {
+	var n int64
+	switch x := value.(type) {
+	case int64:
+		n = x
+	case uint64:
+		if x > math.MaxInt64 {
+			return fmt.Errorf("value %d overflows %T", x, *target)
+		}
+		n = int64(x)
+	default:
+		return fmt.Errorf("cannot convert %T to %T", value, *target)
+	}
+	if int64(T(n)) != n {
+		return fmt.Errorf("value %d overflows %T", n, *target)
+	}
+	*target = T(n)
+	return nil
+}
+
+func setUint[T uint | uint8 | uint16 | uint32 | uint64](value any, target *T) error {
+	var n uint64
+	switch x := value.(type) {
+	case int64:
+		if x < 0 {
+			return fmt.Errorf("value %d overflows %T", x, *target)
+		}
+		n = uint64(x)
+	case uint64:
+		n = x
+	default:
+		return fmt.Errorf("cannot convert %T to %T", value, *target)
+	}
+	if uint64(T(n)) != n {
+		return fmt.Errorf("value %d overflows %T", n, *target)
+	}
+	*target = T(n)
+	return nil
+}
+
+func setFloat[T float32 | float64](value any, target *T) error {
+	switch x := value.(type) {
+	case float64:
+		*target = T(x)
+	case int64:
+		*target = T(x)
+	case uint64:
+		*target = T(x)
+	default:
+		return fmt.Errorf("cannot convert %T to %T", value, *target)
+	}
+	return nil
+}
+
+// decodeScalar finds the enum value of a decoded msgpack or CBOR scalar.
+// data is the encoded input, used in errors.
+func decodeScalar[R comparable, T comparable, E Enum[R, T]](e E, value any, data []byte) (*E, error) {
+	if e.SerdeFormat() == FormatName {
+		name, ok := value.(string)
+		if !ok {
+			return nil, invalidValue[E](data, FormatName, fmt.Errorf("expected a string, got %T", value))
+		}
+		return findNameOrValue(e, name, true)
+	}
+	var rawValue R
+	if err := setScalar(value, &rawValue); err != nil {
+		return nil, invalidValue[E](data, FormatValue, err)
+	}
+	return findNameOrValue(e, rawValue, false)
+}
+
+// appendScalarText appends value formatted as by anyToString. Basic types
+// are handled without reflection or allocations.
+func appendScalarText[R any](dst []byte, value R) ([]byte, error) {
+	switch v := any(value).(type) {
+	case int:
+		return strconv.AppendInt(dst, int64(v), 10), nil
+	case int8:
+		return strconv.AppendInt(dst, int64(v), 10), nil
+	case int16:
+		return strconv.AppendInt(dst, int64(v), 10), nil
+	case int32:
+		return strconv.AppendInt(dst, int64(v), 10), nil
+	case int64:
+		return strconv.AppendInt(dst, v, 10), nil
+	case uint:
+		return strconv.AppendUint(dst, uint64(v), 10), nil
+	case uint8:
+		return strconv.AppendUint(dst, uint64(v), 10), nil
+	case uint16:
+		return strconv.AppendUint(dst, uint64(v), 10), nil
+	case uint32:
+		return strconv.AppendUint(dst, uint64(v), 10), nil
+	case uint64:
+		return strconv.AppendUint(dst, v, 10), nil
+	case float32:
+		return strconv.AppendFloat(dst, float64(v), 'g', -1, 32), nil
+	case float64:
+		return strconv.AppendFloat(dst, v, 'f', -1, 64), nil
+	case bool:
+		return strconv.AppendBool(dst, v), nil
+	case string:
+		return append(dst, v...), nil
+	default:
+		s, err := anyToString(value)
+		if err != nil {
+			return nil, err
+		}
+		return append(dst, s...), nil
+	}
+}
+
+// appendScalarBinary appends value encoded as by anyToBinary. Basic types
+// are handled without reflection or allocations.
+func appendScalarBinary[R any](dst []byte, value R) ([]byte, error) {
+	switch v := any(value).(type) {
+	case int8:
+		return append(dst, byte(v)), nil
+	case int16:
+		return binary.BigEndian.AppendUint16(dst, uint16(v)), nil
+	case int32:
+		return binary.BigEndian.AppendUint32(dst, uint32(v)), nil
+	case int:
+		return binary.BigEndian.AppendUint64(dst, uint64(v)), nil
+	case int64:
+		return binary.BigEndian.AppendUint64(dst, uint64(v)), nil
+	case uint8:
+		return append(dst, v), nil
+	case uint16:
+		return binary.BigEndian.AppendUint16(dst, v), nil
+	case uint32:
+		return binary.BigEndian.AppendUint32(dst, v), nil
+	case uint:
+		return binary.BigEndian.AppendUint64(dst, uint64(v)), nil
+	case uint64:
+		return binary.BigEndian.AppendUint64(dst, v), nil
+	case float32:
+		return binary.BigEndian.AppendUint32(dst, math.Float32bits(v)), nil
+	case float64:
+		return binary.BigEndian.AppendUint64(dst, math.Float64bits(v)), nil
+	case bool:
+		if v {
+			return append(dst, 1), nil
+		}
+		return append(dst, 0), nil
+	case string:
+		return append(dst, v...), nil
+	default:
+		bs, err := anyToBinary(value)
+		if err != nil {
+			return nil, err
+		}
+		return append(dst, bs...), nil
+	}
+}
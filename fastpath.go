@@ -0,0 +1,78 @@
+package main
+
+// valueKind classifies the base type of an enum that serializes its value,
+// so the template can emit specialized code instead of calling the generic,
+// reflection based helpers of the enums package. It returns "int", "uint",
+// "float" or "string", or "" if the generic helpers must be used.
+func valueKind(enum *EnumInfo) string {
+	if enum.Options.SerdeFormat == "name" {
+		return ""
+	}
+	switch enum.BaseType {
+	case "int", "int8", "int16", "int32", "int64":
+		return "int"
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		return "uint"
+	case "float32", "float64":
+		return "float"
+	case "string":
+		return "string"
+	}
+	return ""
+}
+
+// bitSize returns the bitSize argument of strconv.ParseInt, ParseUint and
+// ParseFloat for a base type, matching the generic text decoder.
+func bitSize(baseType string) int {
+	switch baseType {
+	case "int8", "uint8":
+		return 8
+	case "int16", "uint16":
+		return 16
+	case "int32", "uint32", "float32":
+		return 32
+	}
+	return 64
+}
+
+// floatFormat returns the format and precision arguments of
+// strconv.AppendFloat matching the generic text encoder.
+func floatFormat(baseType string) string {
+	if baseType == "float32" {
+		return "'g', -1, 32"
+	}
+	return "'f', -1, 64"
+}
+
+// formatValue returns the Go expression formatting the value of t as text,
+// matching the generic text encoder, for enums with a valueKind.
+func formatValue(enum *EnumInfo) string {
+	field := "t." + enum.Type
+	switch valueKind(enum) {
+	case "int":
+		return "strconv.FormatInt(int64(" + field + "), 10)"
+	case "uint":
+		return "strconv.FormatUint(uint64(" + field + "), 10)"
+	case "float":
+		return "strconv.FormatFloat(float64(" + field + "), " + floatFormat(enum.BaseType) + ")"
+	}
+	return "string(" + field + ")"
+}
+
+// needsStrconv reports whether the specialized code of enum uses strconv.
+func needsStrconv(enum *EnumInfo) bool {
+	switch valueKind(enum) {
+	case "":
+		return false
+	case "string":
+		return enum.Options.JSON
+	case "float":
+		return enum.Options.JSON || enum.Options.Text || enum.Options.XML
+	}
+	return enum.Options.JSON || enum.Options.Text || enum.Options.XML || enum.Options.YAML
+}
+
+// needsStrings reports whether the specialized code of enum uses strings.
+func needsStrings(enum *EnumInfo) bool {
+	return valueKind(enum) != "" && enum.Options.XML
+}
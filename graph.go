@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Supported diagram formats for -graph and <Name>StateDiagram.
+const (
+	graphDOT     = "dot"
+	graphMermaid = "mermaid"
+)
+
+// stateGraph is the state machine of an enum with invalid states removed.
+type stateGraph struct {
+	Name   string
+	States []graphState
+}
+
+type graphState struct {
+	ID      string // Constant name, quoted in DOT where it may be a keyword
+	Label   string // First name of the value
+	Initial bool
+	Final   bool
+	Edges   []graphEdge
+}
+
+type graphEdge struct {
+	To    string
+	Event string // Name of the event triggering the transition, if any
+}
+
+// buildStateGraph collects the states and transitions of enum. Invalid
+// states and transitions into them are omitted.
+func buildStateGraph(enum *EnumInfo) stateGraph {
+	invalid := make(map[string]bool)
+	for _, v := range enum.Values {
+		if v.IsInvalid {
+			invalid[v.Name] = true
+		}
+	}
+
+	g := stateGraph{Name: enum.Name}
+	for _, v := range enum.Values {
+		if v.IsInvalid {
+			continue
+		}
+		state := graphState{ID: v.Name, Label: v.Names[0], Initial: v.IsInitial, Final: v.IsFinal}
+		for _, target := range v.Transitions {
+			if invalid[target] {
+				continue
+			}
+			var events []string
+			for _, e := range v.Events {
+				if e.Target == target {
+					events = append(events, e.Event)
+				}
+			}
+			state.Edges = append(state.Edges, graphEdge{To: target, Event: strings.Join(events, ", ")})
+		}
+		g.States = append(g.States, state)
+	}
+	return g
+}
+
+// renderStateDiagram renders the state machine of enum in the given format.
+func renderStateDiagram(enum *EnumInfo, format string) (string, error) {
+	g := buildStateGraph(enum)
+	switch format {
+	case graphDOT:
+		return g.dot(), nil
+	case graphMermaid:
+		return g.mermaid(), nil
+	default:
+		return "", fmt.Errorf("unknown graph format %q, want %s or %s", format, graphDOT, graphMermaid)
+	}
+}
+
+// dot renders the graph in Graphviz DOT. Initial states are pointed to by an
+// arrow from a dot and final states are drawn as double circles. Every ID is
+// quoted, as names such as Node or Graph are keywords in DOT.
+func (g stateGraph) dot() string {
+	var b strings.Builder
+	q := strconv.Quote
+	fmt.Fprintf(&b, "digraph %s {\n", q(g.Name))
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=box, style=rounded];\n")
+	for _, s := range g.States {
+		if s.Initial {
+			fmt.Fprintf(&b, "\t%s [shape=point];\n", q("__start_"+s.ID))
+		}
+	}
+	for _, s := range g.States {
+		if s.Final {
+			fmt.Fprintf(&b, "\t%s [label=%s, shape=doublecircle, style=bold];\n", q(s.ID), q(s.Label))
+		} else {
+			fmt.Fprintf(&b, "\t%s [label=%s];\n", q(s.ID), q(s.Label))
+		}
+	}
+	for _, s := range g.States {
+		if s.Initial {
+			fmt.Fprintf(&b, "\t%s -> %s;\n", q("__start_"+s.ID), q(s.ID))
+		}
+		for _, e := range s.Edges {
+			if e.Event != "" {
+				fmt.Fprintf(&b, "\t%s -> %s [label=%s];\n", q(s.ID), q(e.To), q(e.Event))
+			} else {
+				fmt.Fprintf(&b, "\t%s -> %s;\n", q(s.ID), q(e.To))
+			}
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// mermaid renders the graph as a Mermaid state diagram. Initial states get an
+// edge from the start marker, final states the "final" class and an edge to
+// the end marker.
+func (g stateGraph) mermaid() string {
+	var b strings.Builder
+	b.WriteString("stateDiagram-v2\n")
+	var finals []string
+	for _, s := range g.States {
+		if s.Label != s.ID {
+			fmt.Fprintf(&b, "    state \"%s\" as %s\n", strings.ReplaceAll(s.Label, `"`, "#quot;"), s.ID)
+		}
+		if s.Final {
+			finals = append(finals, s.ID)
+		}
+	}
+	for _, s := range g.States {
+		if s.Initial {
+			fmt.Fprintf(&b, "    [*] --> %s\n", s.ID)
+		}
+		for _, e := range s.Edges {
+			if e.Event != "" {
+				fmt.Fprintf(&b, "    %s --> %s: %s\n", s.ID, e.To, e.Event)
+			} else {
+				fmt.Fprintf(&b, "    %s --> %s\n", s.ID, e.To)
+			}
+		}
+		if s.Final {
+			fmt.Fprintf(&b, "    %s --> [*]\n", s.ID)
+		}
+	}
+	if len(finals) > 0 {
+		b.WriteString("    classDef final font-weight:bold,stroke-width:3px\n")
+		fmt.Fprintf(&b, "    class %s final\n", strings.Join(finals, ", "))
+	}
+	return b.String()
+}
+
+// printStateDiagrams writes the diagram of every -statemachine enum to stdout.
+func printStateDiagrams(enums []EnumInfo, format string) error {
+	for i := range enums {
+		if !enums[i].Options.StateMachine {
+			continue
+		}
+		diagram, err := renderStateDiagram(&enums[i], format)
+		if err != nil {
+			return err
+		}
+		fmt.Print(diagram)
+	}
+	return nil
+}
+
+// goStringLiteral returns s as a raw string literal when possible.
+func goStringLiteral(s string) string {
+	if strings.Contains(s, "`") || strings.Contains(s, "\r") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestStateDiagram(t *testing.T) {
+	// Node 和 Graph 是 DOT 的关键字
+	enum := &parseSource(t, `package order
+
+// goenums: -statemachine
+type order int
+
+const (
+	// Created
+	// state: [initial] submit -> Node
+	created order = iota
+	// state: ship -> Graph; -> canceled
+	Node
+	// Shipped
+	// state: [final]
+	Graph
+	// state: [final]
+	canceled
+	// invalid
+	unknown
+)
+`)[0]
+	for _, format := range []string{graphDOT, graphMermaid} {
+		t.Run(format, func(t *testing.T) {
+			got, err := renderStateDiagram(enum, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			golden := filepath.Join("testdata", "state_diagram."+format)
+			if *update {
+				if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != string(want) {
+				t.Errorf("%s diagram differs from %s:\n%s", format, golden, got)
+			}
+		})
+	}
+
+	if _, err := renderStateDiagram(enum, "svg"); err == nil {
+		t.Error("renderStateDiagram(svg) should fail")
+	}
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// generatedHeader is the first line of every file written by goenum.
+const generatedHeader = "// Code generated by goenum. DO NOT EDIT."
+
+// Package is a Go package loaded from a single directory.
+type Package struct {
+	Name  string
+	Dir   string
+	Fset  *token.FileSet
+	Files []*SourceFile
+	Info  *types.Info
+}
+
+// SourceFile is a parsed file of a Package together with its raw content.
+type SourceFile struct {
+	Path    string
+	AST     *ast.File
+	Content []byte
+}
+
+// loadPackage parses all non-test Go files of the package in dir that match
+// the current build context. Files previously generated by goenum are skipped
+// so stale output never feeds back into the generator.
+func loadPackage(dir string) (*Package, error) {
+	bp, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &Package{
+		Name: bp.Name,
+		Dir:  dir,
+		Fset: imports.fset,
+	}
+	for _, name := range bp.GoFiles {
+		path := filepath.Join(dir, name)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.HasPrefix(content, []byte(generatedHeader)) {
+			continue
+		}
+		node, err := parser.ParseFile(pkg.Fset, path, content, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		pkg.Files = append(pkg.Files, &SourceFile{Path: path, AST: node, Content: content})
+	}
+	pkg.typeCheck()
+	return pkg, nil
+}
+
+// typeCheck fills pkg.Info with the definitions of the package. Only the
+// declarations constants can depend on are checked, and type errors are
+// ignored: the package may reference code that goenum has not generated
+// yet, and constant declarations can usually be evaluated regardless.
+func (pkg *Package) typeCheck() {
+	pkg.Info = &types.Info{
+		Defs: make(map[*ast.Ident]types.Object),
+	}
+	files := make([]*ast.File, len(pkg.Files))
+	for i, file := range pkg.Files {
+		files[i] = constFile(file.AST)
+	}
+	conf := types.Config{
+		Importer: imports,
+		Error:    func(error) {},
+	}
+	_, _ = conf.Check(pkg.Name, pkg.Fset, files, pkg.Info)
+}
+
+// imports loads the packages imported by the packages of a run. It is
+// shared, so every dependency is loaded at most once.
+var imports = &constImporter{
+	fset:     token.NewFileSet(),
+	resolved: make(map[[2]string]string),
+	packages: make(map[string]*types.Package),
+}
+
+// constImporter is a types.ImporterFrom that loads packages from source,
+// reduced by constFile. Compared to importing them from source with
+// go/importer, only the dependencies that constants and their types refer to
+// are loaded, and function bodies are never type-checked.
+type constImporter struct {
+	fset     *token.FileSet
+	resolved map[[2]string]string      // Directory of an import path, by module root and path
+	packages map[string]*types.Package // Loaded packages by directory
+}
+
+func (imp *constImporter) Import(path string) (*types.Package, error) {
+	return imp.ImportFrom(path, ".", 0)
+}
+
+func (imp *constImporter) ImportFrom(path, srcDir string, _ types.ImportMode) (*types.Package, error) {
+	if path == "unsafe" {
+		return types.Unsafe, nil
+	}
+	// Import paths resolve to the same directory within a module, so only
+	// the first import asks the go command
+	key := [2]string{moduleRoot(srcDir), path}
+	dir, ok := imp.resolved[key]
+	if !ok {
+		// The go command resolves modules from its working directory
+		ctxt := build.Default
+		ctxt.Dir = srcDir
+		bp, err := ctxt.Import(path, srcDir, build.FindOnly)
+		if err != nil {
+			return nil, err
+		}
+		dir = bp.Dir
+		imp.resolved[key] = dir
+	}
+	if pkg, ok := imp.packages[dir]; ok {
+		return pkg, nil
+	}
+
+	bp, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+	var files []*ast.File
+	for _, name := range bp.GoFiles {
+		file, err := parser.ParseFile(imp.fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, constFile(file))
+	}
+	conf := types.Config{
+		Importer: imp,
+		Error:    func(error) {},
+	}
+	pkg, _ := conf.Check(path, imp.fset, files, nil)
+	imp.packages[dir] = pkg
+	return pkg, nil
+}
+
+// moduleRoot returns the directory of the go.mod file enclosing dir, or dir
+// itself if there is none.
+func moduleRoot(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return dir
+	}
+	for d := dir; ; {
+		if _, err := os.Stat(filepath.Join(d, "go.mod")); err == nil {
+			return d
+		}
+		parent := filepath.Dir(d)
+		if parent == d {
+			return dir
+		}
+		d = parent
+	}
+}
+
+// constFile returns a copy of file reduced to the declarations constants can
+// depend on: constant declarations, named types that are not composite, and
+// the imports these refer to. The import name of a package is guessed from
+// its path; if a qualifier matches no guess, every import without an
+// explicit name is kept.
+func constFile(file *ast.File) *ast.File {
+	var decls []ast.Decl
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		switch gen.Tok {
+		case token.CONST:
+			decls = append(decls, gen)
+		case token.TYPE:
+			var specs []ast.Spec
+			for _, spec := range gen.Specs {
+				switch spec.(*ast.TypeSpec).Type.(type) {
+				case *ast.Ident, *ast.SelectorExpr:
+					specs = append(specs, spec)
+				}
+			}
+			if len(specs) > 0 {
+				decls = append(decls, &ast.GenDecl{TokPos: gen.TokPos, Tok: token.TYPE, Specs: specs})
+			}
+		}
+	}
+
+	used := make(map[string]bool)
+	for _, decl := range decls {
+		ast.Inspect(decl, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if x, ok := sel.X.(*ast.Ident); ok {
+					used[x.Name] = true
+				}
+			}
+			return true
+		})
+	}
+	var specs, unnamed []ast.Spec
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		switch name := importName(spec, path); {
+		case name == "." || used[name]:
+			specs = append(specs, spec)
+			delete(used, name)
+		case spec.Name == nil:
+			unnamed = append(unnamed, spec)
+		}
+	}
+	// A qualifier no import is known to provide may name a package whose
+	// name differs from its path, which only loading the package tells
+	if len(used) > 0 {
+		specs = append(specs, unnamed...)
+	}
+	if len(specs) > 0 {
+		decls = append([]ast.Decl{&ast.GenDecl{Tok: token.IMPORT, Specs: specs}}, decls...)
+	}
+	return &ast.File{Package: file.Package, Name: file.Name, Decls: decls}
+}
+
+// importName returns the name an import is referred to by in its file.
+func importName(spec *ast.ImportSpec, path string) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+	elems := strings.Split(path, "/")
+	name := elems[len(elems)-1]
+	if versionElem.MatchString(name) && len(elems) > 1 {
+		name = elems[len(elems)-2]
+	}
+	name, _, _ = strings.Cut(name, ".")
+	return strings.TrimPrefix(name, "go-")
+}
+
+// expandPattern resolves a command line argument to package directories.
+// A trailing "/..." matches the directory and all its subdirectories that
+// contain a buildable package, skipping testdata, vendor and hidden
+// directories.
+func expandPattern(pattern string) ([]string, error) {
+	root, recursive := strings.CutSuffix(pattern, "...")
+	if !recursive {
+		return []string{pattern}, nil
+	}
+	root = strings.TrimSuffix(root, "/")
+	if root == "" {
+		root = "."
+	}
+
+	var dirs []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		name := d.Name()
+		if path != root && (name == "testdata" || name == "vendor" ||
+			strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+			return filepath.SkipDir
+		}
+		// Directories without Go files for the current build context, with
+		// only tests or with files of several packages hold nothing to
+		// generate for
+		if bp, err := build.ImportDir(path, 0); err != nil || len(bp.GoFiles) == 0 {
+			return nil
+		}
+		dirs = append(dirs, path)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dirs, nil
+}
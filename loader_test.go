@@ -0,0 +1,214 @@
+package main
+
+import (
+	"go/ast"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestExpandPattern(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"a/a.go":           "package a\n",
+		"b/c/c.go":         "package c\n",
+		"testdata/x/x.go":  "package x\n",
+		"vendor/v/v.go":    "package v\n",
+		"_skip/s.go":       "package s\n",
+		".hidden/h.go":     "package h\n",
+		"docs/README.md":   "",
+		"multi/p.go":       "package p\n",
+		"multi/q.go":       "package q\n",
+		"tagged/t.go":      "//go:build ignore\n\npackage t\n",
+		"a/testdata/y.go":  "package y\n",
+		"b/c/c_test.go":    "package c\n",
+		"only/o_test.go":   "package o\n",
+		"b/c/vendor/w.go":  "package w\n",
+		"b/c/d/_x/x.go":    "package x\n",
+		"b/c/d/.git/g.go":  "package g\n",
+		"b/c/d/e/e.go":     "package e\n",
+		"b/c/d/e/e_gen.go": "package e\n",
+	})
+
+	dirs, err := expandPattern(root + "/...")
+	if err != nil {
+		t.Fatalf("expandPattern failed: %v", err)
+	}
+	var got []string
+	for _, dir := range dirs {
+		rel, _ := filepath.Rel(root, dir)
+		got = append(got, filepath.ToSlash(rel))
+	}
+	// 跳过 testdata、vendor、隐藏目录以及不包含单个可构建包的目录
+	if want := []string{"a", "b/c", "b/c/d/e"}; !slices.Equal(got, want) {
+		t.Errorf("expandPattern = %v, want %v", got, want)
+	}
+
+	if dirs, err := expandPattern("some/dir"); err != nil || !slices.Equal(dirs, []string{"some/dir"}) {
+		t.Errorf("expandPattern(some/dir) = %v, %v", dirs, err)
+	}
+}
+
+func TestConstantsInOtherFiles(t *testing.T) {
+	enums := parseFiles(t, map[string]string{
+		"types.go": `package order
+
+// goenums: -json
+type status int
+`,
+		"values.go": `package order
+
+const (
+	pending status = iota + 1
+	done
+)
+`,
+	})
+	var got []string
+	for _, v := range enums[0].Values {
+		got = append(got, v.Name+"="+v.Value)
+	}
+	if want := []string{"pending=1", "done=2"}; !slices.Equal(got, want) {
+		t.Errorf("values = %v, want %v", got, want)
+	}
+	// 生成文件以类型声明所在的文件为准
+	if filepath.Base(enums[0].FileName) != "types.go" {
+		t.Errorf("FileName = %s, want types.go", enums[0].FileName)
+	}
+}
+
+func TestImportedConstants(t *testing.T) {
+	// 只加载依赖包的常量，函数体中的错误不影响常量求值
+	enums := parseSource(t, `package web
+
+import (
+	"math"
+	"net/http"
+	"time"
+)
+
+// goenums: -json
+type code int
+
+const (
+	ok       code = http.StatusOK
+	notFound code = http.StatusNotFound
+	big      code = math.MaxInt16
+	second   code = code(time.Second / time.Millisecond)
+)
+
+func broken() { undefined() }
+`)
+	var got []string
+	for _, v := range enums[0].Values {
+		got = append(got, v.Name+"="+v.Value)
+	}
+	if want := []string{"ok=200", "notFound=404", "big=32767", "second=1000"}; !slices.Equal(got, want) {
+		t.Errorf("values = %v, want %v", got, want)
+	}
+}
+
+func TestRenamedImports(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"go.mod":           "module example.com\n\ngo 1.24\n",
+		"go-bar/v2/bar.go": "package baz\n\nconst (\n\tA = 3\n\tB = 5\n)\n",
+		// 导入时重命名
+		"renamed/enums.go": `package renamed
+
+import foo "example.com/go-bar/v2"
+
+// goenums: -json
+type code int
+
+const (
+	a code = foo.A
+	b code = foo.B
+)
+`,
+		// 包名与路径不一致
+		"unnamed/enums.go": `package unnamed
+
+import "example.com/go-bar/v2"
+
+// goenums: -json
+type code int
+
+const (
+	a code = baz.A
+	b code = baz.B
+)
+`,
+	})
+	for _, dir := range []string{"renamed", "unnamed"} {
+		pkg, err := loadPackage(filepath.Join(root, dir))
+		if err != nil {
+			t.Fatalf("loadPackage(%s) failed: %v", dir, err)
+		}
+		enums, err := parsePackage(pkg)
+		if err != nil {
+			t.Fatalf("parsePackage(%s) failed: %v", dir, err)
+		}
+		var got []string
+		for _, v := range enums[0].Values {
+			got = append(got, v.Name+"="+v.Value)
+		}
+		if want := []string{"a=3", "b=5"}; !slices.Equal(got, want) {
+			t.Errorf("%s: values = %v, want %v", dir, got, want)
+		}
+		if diags := validateEnums(enums); len(diags) > 0 {
+			t.Errorf("%s: unexpected diagnostics %v", dir, diags)
+		}
+	}
+}
+
+func TestUnevaluatedConstants(t *testing.T) {
+	// 无法求值的常量报告错误，而不是猜测 iota 的值
+	enums := parseSource(t, `package web
+
+import pb "example.com/missing/pb"
+
+// goenums: -flags -proto
+type code int
+
+const (
+	a code = pb.Code_A
+	b code = pb.Code_B
+	c code = 4
+)
+`)
+	diags := validateEnums(enums)
+	want := []string{
+		"code: cannot evaluate the value of a",
+		"code: cannot evaluate the value of b",
+	}
+	if got := messages(diags); !slices.Equal(got, want) {
+		t.Fatalf("diagnostics = %q, want %q", got, want)
+	}
+	for i, line := range []int{9, 10} {
+		if diags[i].Pos.Line != line || diags[i].Pos.Column != 2 || diags[i].Warning {
+			t.Errorf("%s reported at %s", diags[i].Message, diags[i].Pos)
+		}
+	}
+}
+
+func TestImportName(t *testing.T) {
+	tests := []struct {
+		name, path, want string
+	}{
+		{"", "net/http", "http"},
+		{"", "gopkg.in/yaml.v3", "yaml"},
+		{"", "github.com/x/go-sqlite", "sqlite"},
+		{"", "example.com/api/v2", "api"},
+		{"h", "net/http", "h"},
+	}
+	for _, tt := range tests {
+		spec := &ast.ImportSpec{}
+		if tt.name != "" {
+			spec.Name = ast.NewIdent(tt.name)
+		}
+		if got := importName(spec, tt.path); got != tt.want {
+			t.Errorf("importName(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
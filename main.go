@@ -1,15 +1,23 @@
 package main
 
 import (
-	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
 	"go/ast"
-	"go/parser"
+	"go/constant"
+	"go/format"
 	"go/token"
+	"go/types"
 	"os"
+	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"text/template"
+
+	"github.com/donutnomad/goenum/enums"
 )
 
 type EnumInfo struct {
@@ -22,8 +30,21 @@ type EnumInfo struct {
 	FileName      string
 	BaseType      string
 	ContainerName string
-	AllTags       []string // All unique tags across all values
-	ConstBlock    string   // Raw text of the entire const block
+	AllTags       []string       // All unique tags across all values
+	ConstBlock    string         // Raw text of the entire const block
+	Pos           token.Position // Position of the goenums: comment
+	NameIndex     []NameEntry    // Unique names in declaration order
+	Fallback      string         // Constant unknown inputs decode to, if any
+	Events        []string       // Unique event names of the state machine in declaration order
+	Hierarchical  bool           // Some state declares a parent state
+	ProtoAlias    string         // Name the package of Options.ProtoType is imported as
+}
+
+// NameEntry maps a name to the constant that owns it.
+type NameEntry struct {
+	Name  string
+	Key   string // Name as matched by FromName, see EnumOptions.NameMatch
+	Const string
 }
 
 type EnumValue struct {
@@ -36,7 +57,30 @@ type EnumValue struct {
 	IsInvalid       bool
 	Tags            []string
 	Transitions     []string
+	Events          []EventTransition // Named transitions, a subset of Transitions
+	Selectors       []string          // Wildcard targets such as "*" or "tag:x", expanded into Transitions
+	Rules           []TransitionRule  // Transitions into a target from wildcard sources, e.g. "* -> canceled"
 	IsFinal         bool
+	IsInitial       bool
+	IsFallback      bool           // Marked with a "// fallback" comment
+	Unevaluated     bool           // The type checker could not evaluate the value, which is empty
+	Parent          string         // Constant name of the parent state, declared with "parent="
+	Pos             token.Position // Position of the constant name
+	NamesPos        token.Position // Position of the names comment, or Pos if absent
+	StatePos        token.Position // Position of the state: comment, or Pos if absent
+}
+
+// EventTransition is a transition triggered by a named event.
+type EventTransition struct {
+	Event  string
+	Target string
+}
+
+// TransitionRule is a transition from every state matched by Source, a
+// wildcard such as "*" or "tag:x", to Target.
+type TransitionRule struct {
+	Source string
+	Target string
 }
 
 type EnumOptions struct {
@@ -45,74 +89,214 @@ type EnumOptions struct {
 	YAML         bool
 	Text         bool
 	Binary       bool
+	XML          bool
+	Msgpack      bool
+	CBOR         bool
 	SerdeFormat  string // "name" or "value"
 	GenName      bool
 	StateMachine bool
+	Check        string   // "" for none, "warn" or "strict" state machine analysis
+	Flags        bool     // Values are bit flags with a generated set type
+	NameMatch    string   // "" for exact, "ci" or "normalize"
+	Fallback     string   // Constant or name given with -fallback=<name>
+	FallbackRaw  bool     // Unknown values are preserved instead of replaced
+	Proto        bool     // A protobuf enum definition can be printed with goenum -proto
+	ProtoType    string   // Generated protobuf Go type as <import path>.<Type>, if any
+	UnknownFlags []string // Flags that were not recognized
 }
 
 // FileTemplateData is the data structure passed to the template for generating the output file.
 type FileTemplateData struct {
-	PackageName string
-	Enums       []EnumInfo
-	HasSQL      bool
-	HasYAML     bool
+	PackageName  string
+	Enums        []EnumInfo
+	HasSQL       bool
+	HasContext   bool // An enum has -sql and -statemachine
+	HasYAML      bool
+	HasXML       bool
+	HasStrconv   bool // Specialized serialization code of an enum uses strconv
+	HasStrings   bool // Specialized serialization code of an enum uses strings
+	ProtoImports []ProtoImport
+}
+
+// ProtoImport is an imported package of generated protobuf Go types.
+type ProtoImport struct {
+	Alias string
+	Path  string
 }
 
 func main() {
-	if len(os.Args) < 2 {
-		println("Usage: goenum <file.go>")
+	graph := flag.String("graph", "", "print the state diagrams of -statemachine enums as `dot` or mermaid instead of generating code")
+	table := flag.Bool("table", false, "print the transition tables of -statemachine enums as JSON instead of generating code")
+	proto := flag.Bool("proto", false, "print the protobuf definitions of -proto enums instead of generating code")
+	flag.Usage = func() {
+		println("Usage: goenum [-graph=dot|mermaid | -table | -proto] <file.go | dir | dir/...> ...")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if *graph != "" && *graph != graphDOT && *graph != graphMermaid {
+		println("Error: -graph must be dot or mermaid")
+		os.Exit(1)
+	}
+	modes := 0
+	for _, on := range []bool{*graph != "", *table, *proto} {
+		if on {
+			modes++
+		}
+	}
+	if modes > 1 {
+		println("Error: -graph, -table and -proto cannot be combined")
 		os.Exit(1)
 	}
 
-	filename := os.Args[1]
-	enums, err := parseFile(filename)
+	var tables []EnumInfo
+	for _, arg := range flag.Args() {
+		for _, t := range loadTargets(arg) {
+			switch {
+			case *graph != "":
+				check(t.enums)
+				if err := printStateDiagrams(t.enums, *graph); err != nil {
+					println("Error rendering state diagram:", err.Error())
+					os.Exit(1)
+				}
+			case *table:
+				check(t.enums)
+				tables = append(tables, t.enums...)
+			case *proto:
+				check(t.enums)
+				printProtoEnums(t.enums)
+			default:
+				generate(t.enums, t.output)
+			}
+		}
+	}
+
+	if *table {
+		if err := printTransitionTables(os.Stdout, tables); err != nil {
+			println("Error writing transition tables:", err.Error())
+			os.Exit(1)
+		}
+	}
+}
+
+// target is a set of enums together with the file they are generated into.
+type target struct {
+	enums  []EnumInfo
+	output string
+}
+
+// loadTargets parses the enums of a file, a package directory or a pattern.
+func loadTargets(arg string) []target {
+	if strings.HasSuffix(arg, ".go") {
+		enums, err := parseFile(arg)
+		if err != nil {
+			println("Error parsing file:", err.Error())
+			os.Exit(1)
+		}
+		return []target{{enums, strings.TrimSuffix(arg, ".go") + "_enums.go"}}
+	}
+
+	dirs, err := expandPattern(arg)
 	if err != nil {
-		println("Error parsing file:", err.Error())
+		println("Error resolving pattern:", err.Error())
 		os.Exit(1)
 	}
-
-	if len(enums) > 0 {
-		err := generateEnumsFile(enums, filename)
+	var targets []target
+	for _, dir := range dirs {
+		pkg, err := loadPackage(dir)
 		if err != nil {
-			println("Error generating enums file:", err.Error())
+			println("Error loading package:", err.Error())
 			os.Exit(1)
 		}
+		enums, err := parsePackage(pkg)
+		if err != nil {
+			println("Error parsing package:", err.Error())
+			os.Exit(1)
+		}
+		targets = append(targets, target{enums, filepath.Join(dir, pkg.Name+"_enums.go")})
+	}
+	return targets
+}
+
+// generate validates enums and writes them to outputFile. Diagnostics are
+// printed with their source positions and abort the run.
+func generate(enums []EnumInfo, outputFile string) {
+	if len(enums) == 0 {
+		return
+	}
+
+	check(enums)
+
+	if err := generateEnumsFile(enums, outputFile); err != nil {
+		println("Error generating enums file:", err.Error())
+		os.Exit(1)
 	}
 }
 
+// check prints the diagnostics of enums with their source positions and
+// aborts the run if any of them is not a warning.
+func check(enums []EnumInfo) {
+	failed := false
+	for _, d := range validateEnums(enums) {
+		println(d.String())
+		failed = failed || !d.Warning
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// parseFile returns the enums whose type is declared in filename. The whole
+// package containing the file is loaded, so constants may live in any of its
+// files.
 func parseFile(filename string) ([]EnumInfo, error) {
-	fileContent, err := os.ReadFile(filename)
+	filename = filepath.Clean(filename)
+	pkg, err := loadPackage(filepath.Dir(filename))
 	if err != nil {
 		return nil, err
 	}
-
-	fset := token.NewFileSet()
-	node, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+	all, err := parsePackage(pkg)
 	if err != nil {
 		return nil, err
 	}
 
 	var enums []EnumInfo
-	enumsMap := make(map[string]*EnumInfo) // Map from type name to EnumInfo
+	for _, enum := range all {
+		if enum.FileName == filename {
+			enums = append(enums, enum)
+		}
+	}
+	return enums, nil
+}
+
+// parsePackage collects every goenums type declared in pkg and associates it
+// with the constants of that type from any file of the package.
+func parsePackage(pkg *Package) ([]EnumInfo, error) {
+	var enums []EnumInfo
 
 	// First pass: find all goenums type declarations
-	for _, decl := range node.Decls {
-		genDecl, ok := decl.(*ast.GenDecl)
-		if !ok || genDecl.Tok != token.TYPE {
-			continue
-		}
-		for _, spec := range genDecl.Specs {
-			typeSpec, ok := spec.(*ast.TypeSpec)
-			if !ok {
+	for _, file := range pkg.Files {
+		for _, decl := range file.AST.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
 				continue
 			}
-			if genDecl.Doc != nil {
-				for _, comment := range genDecl.Doc.List {
-					if strings.Contains(comment.Text, "goenums:") {
-						enum := parseEnumFromTypeSpec(typeSpec, comment.Text, node.Name.Name, filename)
-						enums = append(enums, enum)
-						enumsMap[enum.Type] = &enums[len(enums)-1]
-						break
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				if genDecl.Doc != nil {
+					for _, comment := range genDecl.Doc.List {
+						if strings.Contains(comment.Text, "goenums:") {
+							enum := parseEnumFromTypeSpec(typeSpec, comment.Text, pkg.Name, file.Path)
+							enum.Pos = pkg.Fset.Position(comment.Pos())
+							enums = append(enums, enum)
+							break
+						}
 					}
 				}
 			}
@@ -120,43 +304,97 @@ func parseFile(filename string) ([]EnumInfo, error) {
 	}
 
 	// Second pass: find const declarations and associate values
-	for _, decl := range node.Decls {
-		genDecl, ok := decl.(*ast.GenDecl)
-		if !ok || genDecl.Tok != token.CONST {
-			continue
-		}
+	for _, file := range pkg.Files {
+		for _, decl := range file.AST.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.CONST {
+				continue
+			}
 
-		constBlockText := string(fileContent[fset.Position(genDecl.Pos()).Offset:fset.Position(genDecl.End()).Offset])
+			constBlockText := string(file.Content[pkg.Fset.Position(genDecl.Pos()).Offset:pkg.Fset.Position(genDecl.End()).Offset])
 
-		for enumType, enumInfo := range enumsMap {
-			values := parseConstValuesWithContext(constBlockText, genDecl, enumType)
-			if len(values) > 0 {
-				enumInfo.Values = append(enumInfo.Values, values...)
-				if enumInfo.ConstBlock == "" {
-					enumInfo.ConstBlock = constBlockText
+			for i := range enums {
+				enumInfo := &enums[i]
+				values := parseConstValuesWithContext(constBlockText, genDecl, enumInfo.Type, pkg)
+				if len(values) > 0 {
+					enumInfo.Values = append(enumInfo.Values, values...)
+					if enumInfo.ConstBlock == "" {
+						enumInfo.ConstBlock = constBlockText
+					}
 				}
 			}
 		}
 	}
 
-	// Third pass: post-process (e.g., collect tags)
+	// Third pass: post-process (e.g., collect tags, index names, resolve the fallback)
 	for i := range enums {
 		enum := &enums[i]
 		tagSet := make(map[string]bool)
+		nameSet := make(map[string]bool)
+		enum.AllTags = nil
+		enum.NameIndex = nil
+		enum.Fallback = ""
+		enum.Events = nil
+		enum.Hierarchical = false
 		for _, value := range enum.Values {
+			enum.Hierarchical = enum.Hierarchical || value.Parent != ""
+			for _, e := range value.Events {
+				if !slices.Contains(enum.Events, e.Event) {
+					enum.Events = append(enum.Events, e.Event)
+				}
+			}
+			if value.IsFallback && enum.Fallback == "" {
+				enum.Fallback = value.Name
+			}
 			for _, tag := range value.Tags {
-				tagSet[tag] = true
+				if !tagSet[tag] {
+					tagSet[tag] = true
+					enum.AllTags = append(enum.AllTags, tag)
+				}
+			}
+			// The first value declaring a name owns it, so lookups stay
+			// deterministic when names are shared
+			for _, name := range value.Names {
+				key := nameKey(enum.Options.NameMatch, name)
+				if !nameSet[key] {
+					nameSet[key] = true
+					enum.NameIndex = append(enum.NameIndex, NameEntry{Name: name, Key: key, Const: value.Name})
+				}
 			}
 		}
-		enum.AllTags = nil
-		for tag := range tagSet {
-			enum.AllTags = append(enum.AllTags, tag)
+		expandTransitions(enum)
+		if enum.Fallback == "" && enum.Options.Fallback != "" {
+			if value := findValue(enum.Values, enum.Options.Fallback); value != nil {
+				enum.Fallback = value.Name
+			}
 		}
 	}
 
 	return enums, nil
 }
 
+// findValue returns the value whose constant or one of whose names is name.
+func findValue(values []EnumValue, name string) *EnumValue {
+	for i := range values {
+		if values[i].Name == name || slices.Contains(values[i].Names, name) {
+			return &values[i]
+		}
+	}
+	return nil
+}
+
+// nameKey returns the form of name used by FromName for the given matching mode.
+func nameKey(mode string, name string) string {
+	switch mode {
+	case "ci":
+		return enums.FoldName(name)
+	case "normalize":
+		return enums.NormalizeName(name)
+	default:
+		return name
+	}
+}
+
 func FirstUpper(s string) string {
 	if len(s) == 0 {
 		return s
@@ -209,23 +447,52 @@ func parseOptions(comment string) EnumOptions {
 			options.Text = true
 		case part == "-binary":
 			options.Binary = true
+		case part == "-xml":
+			options.XML = true
+		case part == "-msgpack":
+			options.Msgpack = true
+		case part == "-cbor":
+			options.CBOR = true
 		case part == "-serde/name":
 			options.SerdeFormat = "name"
 		case part == "-serde/value":
 			options.SerdeFormat = "value"
+		case part == "-serde/ci":
+			options.NameMatch = "ci"
+		case part == "-serde/normalize":
+			options.NameMatch = "normalize"
 		case part == "-genName":
 			options.GenName = true
 		case part == "-statemachine":
 			options.StateMachine = true
+		case part == "-statemachine/check":
+			options.StateMachine = true
+			options.Check = "warn"
+		case part == "-statemachine/strict":
+			options.StateMachine = true
+			options.Check = "strict"
+		case part == "-flags":
+			options.Flags = true
+		case strings.HasPrefix(part, "-fallback="):
+			options.Fallback = strings.TrimPrefix(part, "-fallback=")
+		case part == "-fallback/raw":
+			options.FallbackRaw = true
+		case part == "-proto":
+			options.Proto = true
+		case strings.HasPrefix(part, "-proto="):
+			options.Proto = true
+			options.ProtoType = strings.TrimPrefix(part, "-proto=")
+		default:
+			options.UnknownFlags = append(options.UnknownFlags, part)
 		}
 	}
 
 	return options
 }
 
-func parseConstValuesWithContext(constBlock string, decl *ast.GenDecl, enumType string) []EnumValue {
+func parseConstValuesWithContext(constBlock string, decl *ast.GenDecl, enumType string, pkg *Package) []EnumValue {
 	// First parse using AST to get the structured data
-	astValues := parseConstValues(decl, enumType)
+	astValues := parseConstValues(decl, enumType, pkg)
 
 	// Then parse the raw text to associate comments
 	lines := strings.Split(constBlock, "\n")
@@ -263,8 +530,9 @@ func parseConstValuesWithContext(constBlock string, decl *ast.GenDecl, enumType
 			parts := strings.Fields(trimmed)
 			if len(parts) >= 1 {
 				candidateName := parts[0]
-				// Check if this name exists in our AST map
-				if _, exists := astMap[candidateName]; exists {
+				// Check if this name exists in our AST map, or is a blank
+				// identifier that consumes an iota value
+				if _, exists := astMap[candidateName]; exists || candidateName == "_" {
 					enumName = candidateName
 					isEnumDefinition = true
 				}
@@ -278,8 +546,8 @@ func parseConstValuesWithContext(constBlock string, decl *ast.GenDecl, enumType
 				value.PrecedingLines = make([]string, len(currentPrecedingLines))
 				copy(value.PrecedingLines, currentPrecedingLines)
 				values = append(values, value)
-				currentPrecedingLines = nil // Reset for next enum
 			}
+			currentPrecedingLines = nil // Reset for next enum
 		} else {
 			// This is a comment, separator, or empty line
 			currentPrecedingLines = append(currentPrecedingLines, line)
@@ -289,9 +557,8 @@ func parseConstValuesWithContext(constBlock string, decl *ast.GenDecl, enumType
 	return values
 }
 
-func parseConstValues(decl *ast.GenDecl, enumType string) []EnumValue {
+func parseConstValues(decl *ast.GenDecl, enumType string, pkg *Package) []EnumValue {
 	var values []EnumValue
-	iotaValue := -1
 	var lastValueSpec *ast.ValueSpec
 
 	for _, spec := range decl.Specs {
@@ -300,45 +567,40 @@ func parseConstValues(decl *ast.GenDecl, enumType string) []EnumValue {
 			if vs.Type == nil && lastValueSpec != nil {
 				vs.Type = lastValueSpec.Type
 			}
-
-			// Check if this spec belongs to our enum type
-			if typeIdent, ok := vs.Type.(*ast.Ident); !ok || typeIdent.Name != enumType {
-				continue
-			}
-
 			// If values are not specified, inherit from the previous spec
 			if len(vs.Values) == 0 && lastValueSpec != nil {
 				vs.Values = lastValueSpec.Values
 			}
+			lastValueSpec = vs
 
-			// Increment iota for each new spec
-			iotaValue++
+			for _, name := range vs.Names {
+				if name.Name == "_" {
+					continue
+				}
+
+				// Prefer the type checker: it knows the exact type and value of
+				// every constant expression. Fall back to the syntax to find the
+				// type of constants it could not evaluate.
+				obj, _ := pkg.Info.Defs[name].(*types.Const)
+				if obj != nil && obj.Val().Kind() != constant.Unknown {
+					if !isNamedType(obj.Type(), enumType) {
+						continue
+					}
+				} else if typeIdent, ok := vs.Type.(*ast.Ident); !ok || typeIdent.Name != enumType {
+					continue
+				}
 
-			for i, name := range vs.Names {
 				value := EnumValue{
 					Name: name.Name,
+					Pos:  pkg.Fset.Position(name.Pos()),
 				}
 
-				// Parse value - handle iota and explicit values
-				if vs.Values != nil && i < len(vs.Values) {
-					valExpr := vs.Values[i]
-					if bl, ok := valExpr.(*ast.BasicLit); ok {
-						value.Value = bl.Value
-						if bl.Kind == token.INT {
-							if _, err := strconv.Atoi(bl.Value); err == nil {
-								// This is an explicit integer value, but iota continues based on position
-							}
-						}
-					} else if ident, ok := valExpr.(*ast.Ident); ok && ident.Name == "iota" {
-						value.Value = strconv.Itoa(iotaValue)
-					} else {
-						// This handles cases where the value is an expression,
-						// or where iota is used implicitly.
-						value.Value = strconv.Itoa(iotaValue)
-					}
+				if obj != nil && obj.Val().Kind() != constant.Unknown {
+					value.Value = formatConstValue(obj.Val())
 				} else {
-					// No explicit value, use iotaValue
-					value.Value = strconv.Itoa(iotaValue)
+					// Guessing the value from the syntax would silently generate
+					// wrong code, so validateEnum reports it instead
+					value.Unevaluated = true
 				}
 
 				// Parse comment
@@ -354,21 +616,63 @@ func parseConstValues(decl *ast.GenDecl, enumType string) []EnumValue {
 					}
 				}
 
-				if commentText != "" {
-					value.Comment = commentText
-					value.OriginalComment = commentText
-					parseValueComment(&value, commentText)
-				}
+				value.Comment = commentText
+				value.OriginalComment = commentText
+				parseValueComment(&value, commentText)
+
+				value.NamesPos = commentPos(pkg.Fset, vs, isNamesLine, value.Pos)
+				value.StatePos = commentPos(pkg.Fset, vs, func(line string) bool {
+					return strings.HasPrefix(line, "state:")
+				}, value.Pos)
 
 				values = append(values, value)
 			}
-			lastValueSpec = vs
 		}
 	}
 
 	return values
 }
 
+// commentPos returns the position of the first comment line of vs matching
+// match, or fallback if there is none.
+func commentPos(fset *token.FileSet, vs *ast.ValueSpec, match func(line string) bool, fallback token.Position) token.Position {
+	for _, group := range []*ast.CommentGroup{vs.Doc, vs.Comment} {
+		if group == nil {
+			continue
+		}
+		for _, c := range group.List {
+			if match(strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))) {
+				return fset.Position(c.Pos())
+			}
+		}
+	}
+	return fallback
+}
+
+// isNamesLine reports whether a comment line lists the names of a value.
+func isNamesLine(line string) bool {
+	return line != "" && line != "invalid" && line != "fallback" && !strings.Contains(line, ":")
+}
+
+// isNamedType reports whether t is the package-level named type called name.
+func isNamedType(t types.Type, name string) bool {
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Name() == name
+}
+
+// formatConstValue renders a constant value as Go source.
+func formatConstValue(v constant.Value) string {
+	switch v.Kind() {
+	case constant.String:
+		return strconv.Quote(constant.StringVal(v))
+	case constant.Float:
+		f, _ := constant.Float64Val(v)
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	default:
+		return v.ExactString()
+	}
+}
+
 func parseValueComment(value *EnumValue, comment string) {
 	lines := strings.Split(comment, "\n")
 
@@ -385,16 +689,53 @@ func parseValueComment(value *EnumValue, comment string) {
 			continue
 		}
 
+		if line == "fallback" {
+			value.IsFallback = true
+			continue
+		}
+
 		if strings.HasPrefix(line, "state:") {
 			stateInfo := strings.TrimSpace(strings.TrimPrefix(line, "state:"))
-			if stateInfo == "[final]" {
-				value.IsFinal = true
-			} else if strings.HasPrefix(stateInfo, "->") {
-				transitionsStr := strings.TrimSpace(strings.TrimPrefix(stateInfo, "->"))
-				if transitionsStr != "" {
-					value.Transitions = strings.Split(transitionsStr, ",")
-					for i, t := range value.Transitions {
-						value.Transitions[i] = strings.TrimSpace(t)
+			// Markers and the parent precede the transitions in any order
+			for {
+				if rest, ok := strings.CutPrefix(stateInfo, "[initial]"); ok {
+					value.IsInitial = true
+					stateInfo = strings.TrimSpace(rest)
+				} else if rest, ok := strings.CutPrefix(stateInfo, "[final]"); ok {
+					value.IsFinal = true
+					stateInfo = strings.TrimSpace(rest)
+				} else if rest, ok := strings.CutPrefix(stateInfo, "parent="); ok {
+					end := strings.IndexAny(rest, " \t;")
+					if end < 0 {
+						end = len(rest)
+					}
+					value.Parent = rest[:end]
+					stateInfo = strings.TrimSpace(rest[end:])
+				} else {
+					break
+				}
+			}
+			// Transitions are "-> a, b" or named "event -> a; other -> b"
+			for _, segment := range strings.Split(stateInfo, ";") {
+				event, targets, ok := strings.Cut(segment, "->")
+				if !ok {
+					continue
+				}
+				event = strings.TrimSpace(event)
+				for _, target := range strings.Split(targets, ",") {
+					target = strings.TrimSpace(target)
+					if target == "" {
+						continue
+					}
+					if isStateSelector(event) {
+						value.Rules = append(value.Rules, TransitionRule{Source: event, Target: target})
+						continue
+					}
+					if !slices.Contains(value.Transitions, target) {
+						value.Transitions = append(value.Transitions, target)
+					}
+					if event != "" {
+						value.Events = append(value.Events, EventTransition{Event: event, Target: target})
 					}
 				}
 			}
@@ -458,41 +799,77 @@ func convertStateNames(line string, nameMap map[string]string) string {
 	return line
 }
 
-func generateEnumsFile(enums []EnumInfo, sourceFilename string) error {
-	outputFile := strings.TrimSuffix(sourceFilename, ".go") + "_enums.go"
-
-	file, err := os.Create(outputFile)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	w := bufio.NewWriter(file)
-	defer w.Flush()
-
+func generateEnumsFile(enums []EnumInfo, outputFile string) error {
 	// Determine required imports
 	hasSQL := false
+	hasContext := false
 	hasYAML := false
+	hasXML := false
+	hasStrconv := false
+	hasStrings := false
 	for _, e := range enums {
+		hasStrconv = hasStrconv || needsStrconv(&e)
+		hasStrings = hasStrings || needsStrings(&e)
 		if e.Options.SQL {
 			hasSQL = true
+			hasContext = hasContext || e.Options.StateMachine
 		}
 		if e.Options.YAML {
 			hasYAML = true
 		}
+		if e.Options.XML {
+			hasXML = true
+		}
 	}
 
+	protoImports := assignProtoImports(enums)
+
 	data := FileTemplateData{
-		PackageName: enums[0].PackageName,
-		Enums:       enums,
-		HasSQL:      hasSQL,
-		HasYAML:     hasYAML,
+		PackageName:  enums[0].PackageName,
+		Enums:        enums,
+		HasSQL:       hasSQL,
+		HasContext:   hasContext,
+		HasYAML:      hasYAML,
+		HasXML:       hasXML,
+		HasStrconv:   hasStrconv,
+		HasStrings:   hasStrings,
+		ProtoImports: protoImports,
 	}
 
 	// Execute template
 	tmpl := template.Must(template.New("enumsFile").Funcs(template.FuncMap{
 		"FirstUpper": FirstUpper,
-		"ToLower":    strings.ToLower,
+		"StateName": func(enum EnumInfo, name string) string {
+			return stateName(&enum, name)
+		},
+		"ValidTargets": func(enum EnumInfo, v EnumValue) []string {
+			return validTargets(&enum, v)
+		},
+		"Sources": func(enum EnumInfo, target string) []string {
+			return sourceStates(&enum, target)
+		},
+		"ValueKind": func(enum EnumInfo) string {
+			return valueKind(&enum)
+		},
+		"FormatValue": func(enum EnumInfo) string {
+			return formatValue(&enum)
+		},
+		"BitSize":     bitSize,
+		"FloatFormat": floatFormat,
+		"Proto": func(enum EnumInfo) protoEnum {
+			return protoEnumOf(&enum)
+		},
+		"Children": func(enum EnumInfo, parent string) []string {
+			return childStates(&enum, parent)
+		},
+		"Reachability": func(enum EnumInfo) []StateReach {
+			return reachability(&enum)
+		},
+		"StateDiagram": func(enum EnumInfo) (string, error) {
+			diagram, err := renderStateDiagram(&enum, graphMermaid)
+			return goStringLiteral(diagram), err
+		},
+		"ToLower": strings.ToLower,
 		"FormatPrecedingLines": func(lines []string, enumValues []EnumValue, currentValue EnumValue) string {
 			if len(lines) == 0 {
 				return ""
@@ -530,23 +907,52 @@ func generateEnumsFile(enums []EnumInfo, sourceFilename string) error {
 		},
 	}).Parse(fileTemplate))
 
-	return tmpl.Execute(w, data)
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		// Write the unformatted output so the problem can be inspected.
+		_ = os.WriteFile(outputFile, buf.Bytes(), 0644)
+		return fmt.Errorf("formatting %s: %w", outputFile, err)
+	}
+	return os.WriteFile(outputFile, src, 0644)
 }
 
-const fileTemplate = `// Code generated by goenum. DO NOT EDIT.
+const fileTemplate = generatedHeader + `
 
 package {{.PackageName}}
 
 import (
+	{{- if .HasContext}}
+	"context"
+	{{- end}}
+	{{- if .HasSQL}}
 	"database/sql/driver"
+	{{- end}}
+	{{- if .HasXML}}
+	"encoding/xml"
+	{{- end}}
 	"fmt"
 	"github.com/donutnomad/goenum/enums"
 	"iter"
+	{{- if .HasStrconv}}
+	"strconv"
+	{{- end}}
+	{{- if .HasStrings}}
+	"strings"
+	{{- end}}
 	{{- if .HasYAML}}
 	"gopkg.in/yaml.v3"
 	{{- end}}
+	{{- range .ProtoImports}}
+	{{.Alias}} "{{.Path}}"
+	{{- end}}
 )
 {{range $enum := .Enums}}
+{{- $kind := ValueKind $enum}}
 // =================================================================================================
 // {{.Name}}
 // =================================================================================================
@@ -591,6 +997,14 @@ var {{ToLower .Name}}NamesMap = map[{{.Name}}][]string{
 	{{- end}}
 }
 
+
+// {{ToLower .Name}}NameIndex maps every name to its enum value
+var {{ToLower .Name}}NameIndex = map[string]{{.Name}}{
+	{{- range .NameIndex}}
+	"{{.Key}}": {{$enum.ContainerName}}.{{FirstUpper .Const}},
+	{{- end}}
+}
+
 {{- if .AllTags}}
 // {{ToLower .Name}}TagsMap maps enum values to their tags array
 var {{ToLower .Name}}TagsMap = map[{{.Name}}][]string{
@@ -699,29 +1113,72 @@ func (t {{.Name}}) SerdeFormat() enums.Format {
 }
 
 // FromName implements the Enum interface.
+{{- if eq .Options.NameMatch "ci"}}
+// Names are matched case-insensitively.
+{{- else if eq .Options.NameMatch "normalize"}}
+// Names are matched ignoring case and the separators '_', '-', ' ' and '.'.
+{{- end}}
 func (t {{.Name}}) FromName(name string) ({{.Name}}, bool) {
-	for enumValue, names := range {{ToLower .Name}}NamesMap {
-		for _, n := range names {
-			if n == name {
-				return enumValue, enumValue.IsValid()
-			}
-		}
+	{{- if eq .Options.NameMatch "ci"}}
+	name = enums.FoldName(name)
+	{{- else if eq .Options.NameMatch "normalize"}}
+	name = enums.NormalizeName(name)
+	{{- end}}
+	if v, ok := {{ToLower .Name}}NameIndex[name]; ok {
+		return v, v.IsValid()
 	}
 	var zero {{.Name}}
 	return zero, false
 }
 
 // FromValue implements the Enum interface.
+// Invalid values are never returned.
 func (t {{.Name}}) FromValue(value {{.BaseType}}) ({{.Name}}, bool) {
-	for v := range {{.ContainerName}}.All() {
-		if v.Val() == value {
-			return v, true
-		}
+	switch {{.Type}}(value) {
+	{{- range .Values}}
+	{{- if not .IsInvalid}}
+	case {{.Name}}:
+		return {{$enum.ContainerName}}.{{FirstUpper .Name}}, true
+	{{- end}}
+	{{- end}}
 	}
 	var zero {{.Name}}
 	return zero, false
 }
 
+{{- if .Fallback}}
+
+// Fallback implements the enums.FallbackEnum interface.
+{{- if .Options.FallbackRaw}}
+// Unknown values are preserved so that they marshal back unchanged, unknown
+// names decode to {{.ContainerName}}.{{FirstUpper .Fallback}}.
+{{- else}}
+// Unknown names and values decode to {{.ContainerName}}.{{FirstUpper .Fallback}}.
+{{- end}}
+func (t {{.Name}}) Fallback(input any, format enums.Format) {{.Name}} {
+	{{- if .Options.FallbackRaw}}
+	if value, ok := input.({{.BaseType}}); ok && format == enums.FormatValue {
+		return {{.Name}}{ {{.Type}}(value) }
+	}
+	{{- end}}
+	return {{.ContainerName}}.{{FirstUpper .Fallback}}
+}
+
+// IsUnknown returns true if t is {{.ContainerName}}.{{FirstUpper .Fallback}}
+{{- if .Options.FallbackRaw}} or a value preserved from an unknown input{{end}}.
+func (t {{.Name}}) IsUnknown() bool {
+	switch t.{{.Type}} {
+	{{- range .Values}}
+	{{- if ne .Name $enum.Fallback}}
+	case {{.Name}}:
+		return false
+	{{- end}}
+	{{- end}}
+	}
+	return true
+}
+{{- end}}
+
 {{- if .AllTags}}
 {{- range .AllTags}}
 
@@ -767,6 +1224,27 @@ func (t {{.Type}}Container) FromValue(value {{.BaseType}}) ({{.Name}}, bool) {
 
 // Scan implements the database/sql.Scanner interface for {{.Name}}.
 func (t *{{.Name}}) Scan(value any) error {
+	{{- if eq $kind "int" "uint" "float"}}
+	if v, ok := value.({{if eq $kind "float"}}float64{{else}}int64{{end}}); ok && {{if eq $kind "uint"}}v >= 0 && {{end}}{{if eq $kind "float"}}float64{{else}}int64{{end}}({{.BaseType}}(v)) == v {
+		if result, ok := t.FromValue({{.BaseType}}(v)); ok {
+			*t = result
+			return nil
+		}
+	}
+	{{- else if eq $kind "string"}}
+	switch v := value.(type) {
+	case string:
+		if result, ok := t.FromValue(v); ok {
+			*t = result
+			return nil
+		}
+	case []byte:
+		if result, ok := t.FromValue(string(v)); ok {
+			*t = result
+			return nil
+		}
+	}
+	{{- end}}
 	result, err := enums.SQLScan(*t, value)
 	if err != nil {
 		return err
@@ -777,7 +1255,15 @@ func (t *{{.Name}}) Scan(value any) error {
 
 // Value implements the database/sql/driver.Valuer interface for {{.Name}}.
 func (t {{.Name}}) Value() (driver.Value, error) {
+	{{- if eq $kind "int" "uint"}}
+	return int64(t.{{.Type}}), nil
+	{{- else if eq $kind "float"}}
+	return float64(t.{{.Type}}), nil
+	{{- else if eq $kind "string"}}
+	return string(t.{{.Type}}), nil
+	{{- else}}
 	return enums.SQLValue(t)
+	{{- end}}
 }
 {{- end}}
 
@@ -785,11 +1271,50 @@ func (t {{.Name}}) Value() (driver.Value, error) {
 
 // MarshalJSON implements the json.Marshaler interface for {{.Name}}.
 func (t {{.Name}}) MarshalJSON() ([]byte, error) {
+	{{- if eq $kind "int"}}
+	return strconv.AppendInt(nil, int64(t.{{.Type}}), 10), nil
+	{{- else if eq $kind "uint"}}
+	return strconv.AppendUint(nil, uint64(t.{{.Type}}), 10), nil
+	{{- else if eq $kind "float"}}
+	return strconv.AppendFloat(nil, float64(t.{{.Type}}), {{FloatFormat .BaseType}}), nil
+	{{- else if eq $kind "string"}}
+	// Values without characters that JSON escapes are quoted without enums.MarshalJSON
+	s := string(t.{{.Type}})
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < ' ' || c > '~' || c == '"' || c == '\\' || c == '<' || c == '>' || c == '&' {
+			return enums.MarshalJSON(t, t.{{.Type}})
+		}
+	}
+	return append(append(append(make([]byte, 0, len(s)+2), '"'), s...), '"'), nil
+	{{- else}}
 	return enums.MarshalJSON(t, t.{{.Type}})
+	{{- end}}
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface for {{.Name}}.
 func (t *{{.Name}}) UnmarshalJSON(data []byte) error {
+	{{- if eq $kind "int" "uint"}}
+	// Known values in canonical form are decoded without enums.UnmarshalJSON
+	if v, err := strconv.Parse{{if eq $kind "int"}}Int{{else}}Uint{{end}}(string(data), 10, {{BitSize .BaseType}}); err == nil {
+		var buf [20]byte
+		if string(strconv.Append{{if eq $kind "int"}}Int{{else}}Uint{{end}}(buf[:0], v, 10)) == string(data) {
+			if result, ok := t.FromValue({{.BaseType}}(v)); ok {
+				*t = result
+				return nil
+			}
+		}
+	}
+	{{- else if eq $kind "string"}}
+	// Known values without escape sequences are decoded without enums.UnmarshalJSON
+	if n := len(data); n >= 2 && data[0] == '"' && data[n-1] == '"' {
+		if v, err := strconv.Unquote(string(data)); err == nil && len(v) == n-2 {
+			if result, ok := t.FromValue({{.BaseType}}(v)); ok {
+				*t = result
+				return nil
+			}
+		}
+	}
+	{{- end}}
 	result, err := enums.UnmarshalJSON(*t, data)
 	if err != nil {
 		return err
@@ -803,11 +1328,40 @@ func (t *{{.Name}}) UnmarshalJSON(data []byte) error {
 
 // MarshalYAML implements the yaml.Marshaler interface for {{.Name}}.
 func (t {{.Name}}) MarshalYAML() (any, error) {
+	{{- if eq $kind "int" "uint"}}
+	return int64(t.{{.Type}}), nil
+	{{- else if eq $kind "float"}}
+	return float64(t.{{.Type}}), nil
+	{{- else if eq $kind "string"}}
+	return string(t.{{.Type}}), nil
+	{{- else}}
 	return enums.MarshalYAML(t, t.{{.Type}})
+	{{- end}}
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface for {{.Name}}.
 func (t *{{.Name}}) UnmarshalYAML(node *yaml.Node) error {
+	{{- if eq $kind "int" "uint"}}
+	// Known values in canonical form are decoded without enums.UnmarshalYAML
+	if node.Kind == yaml.ScalarNode && node.ShortTag() == "!!int" {
+		if v, err := strconv.Parse{{if eq $kind "int"}}Int{{else}}Uint{{end}}(node.Value, 10, {{BitSize .BaseType}}); err == nil {
+			var buf [20]byte
+			if string(strconv.Append{{if eq $kind "int"}}Int{{else}}Uint{{end}}(buf[:0], v, 10)) == node.Value {
+				if result, ok := t.FromValue({{.BaseType}}(v)); ok {
+					*t = result
+					return nil
+				}
+			}
+		}
+	}
+	{{- else if eq $kind "string"}}
+	if node.Kind == yaml.ScalarNode && node.ShortTag() == "!!str" {
+		if result, ok := t.FromValue(node.Value); ok {
+			*t = result
+			return nil
+		}
+	}
+	{{- end}}
 	result, err := enums.UnmarshalYAML(*t, node)
 	if err != nil {
 		return err
@@ -821,11 +1375,39 @@ func (t *{{.Name}}) UnmarshalYAML(node *yaml.Node) error {
 
 // MarshalText implements the encoding.TextMarshaler interface for {{.Name}}.
 func (t {{.Name}}) MarshalText() ([]byte, error) {
+	{{- if eq $kind "int"}}
+	return strconv.AppendInt(nil, int64(t.{{.Type}}), 10), nil
+	{{- else if eq $kind "uint"}}
+	return strconv.AppendUint(nil, uint64(t.{{.Type}}), 10), nil
+	{{- else if eq $kind "float"}}
+	return strconv.AppendFloat(nil, float64(t.{{.Type}}), {{FloatFormat .BaseType}}), nil
+	{{- else if eq $kind "string"}}
+	return []byte(t.{{.Type}}), nil
+	{{- else}}
 	return enums.MarshalText(t, t.{{.Type}})
+	{{- end}}
+}
+
+// AppendText implements the encoding.TextAppender interface for {{.Name}}.
+func (t {{.Name}}) AppendText(b []byte) ([]byte, error) {
+	return enums.AppendText(t, b)
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for {{.Name}}.
 func (t *{{.Name}}) UnmarshalText(data []byte) error {
+	{{- if eq $kind "int" "uint" "float"}}
+	if v, err := strconv.Parse{{if eq $kind "int"}}Int(string(data), 10, {{else if eq $kind "uint"}}Uint(string(data), 10, {{else}}Float(string(data), {{end}}{{BitSize .BaseType}}); err == nil {
+		if result, ok := t.FromValue({{.BaseType}}(v)); ok {
+			*t = result
+			return nil
+		}
+	}
+	{{- else if eq $kind "string"}}
+	if result, ok := t.FromValue(string(data)); ok {
+		*t = result
+		return nil
+	}
+	{{- end}}
 	result, err := enums.UnmarshalText(*t, data)
 	if err != nil {
 		return err
@@ -839,7 +1421,16 @@ func (t *{{.Name}}) UnmarshalText(data []byte) error {
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for {{.Name}}.
 func (t {{.Name}}) MarshalBinary() ([]byte, error) {
+	{{- if $kind}}
+	return enums.AppendBinary(t, nil)
+	{{- else}}
 	return enums.MarshalBinary(t, t.{{.Type}})
+	{{- end}}
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for {{.Name}}.
+func (t {{.Name}}) AppendBinary(b []byte) ([]byte, error) {
+	return enums.AppendBinary(t, b)
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for {{.Name}}.
@@ -853,6 +1444,292 @@ func (t *{{.Name}}) UnmarshalBinary(data []byte) error {
 }
 {{- end}}
 
+{{- if .Options.XML}}
+
+// MarshalXML implements the xml.Marshaler interface for {{.Name}}.
+func (t {{.Name}}) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	{{- if $kind}}
+	return e.EncodeElement({{FormatValue $enum}}, start)
+	{{- else}}
+	return enums.MarshalXML(t, t.{{.Type}}, e, start)
+	{{- end}}
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for {{.Name}}.
+func (t *{{.Name}}) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	{{- if $kind}}
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return &enums.InvalidValueError{Type: "{{.Name}}", Format: t.SerdeFormat(), Err: err}
+	}
+	return t.UnmarshalXMLAttr(xml.Attr{Name: start.Name, Value: strings.TrimSpace(text)})
+	{{- else}}
+	result, err := enums.UnmarshalXML(*t, d, start)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+	{{- end}}
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for {{.Name}}.
+func (t {{.Name}}) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	{{- if $kind}}
+	return xml.Attr{Name: name, Value: {{FormatValue $enum}}}, nil
+	{{- else}}
+	return enums.MarshalXMLAttr(t, t.{{.Type}}, name)
+	{{- end}}
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for {{.Name}}.
+func (t *{{.Name}}) UnmarshalXMLAttr(attr xml.Attr) error {
+	{{- if eq $kind "int" "uint" "float"}}
+	if v, err := strconv.Parse{{if eq $kind "int"}}Int(attr.Value, 10, {{else if eq $kind "uint"}}Uint(attr.Value, 10, {{else}}Float(attr.Value, {{end}}{{BitSize .BaseType}}); err == nil {
+		if result, ok := t.FromValue({{.BaseType}}(v)); ok {
+			*t = result
+			return nil
+		}
+	}
+	{{- else if eq $kind "string"}}
+	if result, ok := t.FromValue(attr.Value); ok {
+		*t = result
+		return nil
+	}
+	{{- end}}
+	result, err := enums.UnmarshalXMLAttr(*t, attr)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+{{- end}}
+
+{{- if .Options.Msgpack}}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface for {{.Name}}.
+func (t {{.Name}}) MarshalMsgpack() ([]byte, error) {
+	{{- if $kind}}
+	return enums.AppendMsgpack(t, nil)
+	{{- else}}
+	return enums.MarshalMsgpack(t, t.{{.Type}})
+	{{- end}}
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface for {{.Name}}.
+func (t *{{.Name}}) UnmarshalMsgpack(data []byte) error {
+	result, err := enums.UnmarshalMsgpack(*t, data)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+{{- end}}
+
+{{- if .Options.CBOR}}
+
+// MarshalCBOR implements the cbor.Marshaler interface for {{.Name}}.
+func (t {{.Name}}) MarshalCBOR() ([]byte, error) {
+	{{- if $kind}}
+	return enums.AppendCBOR(t, nil)
+	{{- else}}
+	return enums.MarshalCBOR(t, t.{{.Type}})
+	{{- end}}
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface for {{.Name}}.
+func (t *{{.Name}}) UnmarshalCBOR(data []byte) error {
+	result, err := enums.UnmarshalCBOR(*t, data)
+	if err != nil {
+		return err
+	}
+	*t = *result
+	return nil
+}
+{{- end}}
+
+{{- if .ProtoAlias}}
+{{- $proto := Proto $enum}}
+{{- $zero := index $proto.Values 0}}
+
+// ToProto converts the value to the protobuf enum {{$proto.GoType}}.
+// Invalid values convert to {{$zero.Name}}.
+func (t {{.Name}}) ToProto() {{$proto.GoType}} {
+	switch t {
+	{{- range $proto.Values}}
+	{{- if not .Zero}}
+	case {{$enum.ContainerName}}.{{FirstUpper .Const}}:
+		return {{.GoConst}}
+	{{- end}}
+	{{- end}}
+	}
+	return {{$zero.GoConst}}
+}
+
+// FromProto returns the value of the protobuf enum value v. It returns false
+// for {{$zero.Name}} and values unknown to {{.Name}}.
+func (t {{.Name}}) FromProto(v {{$proto.GoType}}) ({{.Name}}, bool) {
+	switch v {
+	{{- range $proto.Values}}
+	{{- if not .Zero}}
+	case {{.GoConst}}:
+		return {{$enum.ContainerName}}.{{FirstUpper .Const}}, true
+	{{- end}}
+	{{- end}}
+	}
+	var zero {{.Name}}
+	return zero, false
+}
+{{- end}}
+
+{{- if .Options.Flags}}
+
+// {{.Name}}Set is a set of {{.Name}} bit flags.
+// The zero value is an empty set.
+type {{.Name}}Set struct {
+	mask {{.BaseType}}
+}
+
+// New{{.Name}}Set returns a set containing the given flags.
+func New{{.Name}}Set(values ...{{.Name}}) {{.Name}}Set {
+	return {{.Name}}Set{}.Add(values...)
+}
+
+// {{.Name}}SetFromMask returns the set represented by mask.
+// It returns false if mask contains bits that are not defined flags.
+func {{.Name}}SetFromMask(mask {{.BaseType}}) ({{.Name}}Set, bool) {
+	if mask&^enums.FlagsMask({{.Name}}{}) != 0 {
+		return {{.Name}}Set{}, false
+	}
+	return {{.Name}}Set{mask: mask}, true
+}
+
+// Parse{{.Name}}Set parses flag names separated by "|", e.g. "A|B".
+func Parse{{.Name}}Set(s string) ({{.Name}}Set, error) {
+	mask, err := enums.ParseFlags({{.Name}}{}, s)
+	if err != nil {
+		return {{.Name}}Set{}, err
+	}
+	return {{.Name}}Set{mask: mask}, nil
+}
+
+// Mask returns the bit mask of the set.
+func (s {{.Name}}Set) Mask() {{.BaseType}} {
+	return s.mask
+}
+
+// IsEmpty returns true if no flag is set.
+func (s {{.Name}}Set) IsEmpty() bool {
+	return s.mask == 0
+}
+
+// Has returns true if the flag is set.
+func (s {{.Name}}Set) Has(v {{.Name}}) bool {
+	bit := v.Val()
+	return bit != 0 && s.mask&bit == bit
+}
+
+// Add returns a copy of the set with the given flags set.
+func (s {{.Name}}Set) Add(values ...{{.Name}}) {{.Name}}Set {
+	for _, v := range values {
+		s.mask |= v.Val()
+	}
+	return s
+}
+
+// Remove returns a copy of the set with the given flags cleared.
+func (s {{.Name}}Set) Remove(values ...{{.Name}}) {{.Name}}Set {
+	for _, v := range values {
+		s.mask &^= v.Val()
+	}
+	return s
+}
+
+// Toggle returns a copy of the set with the given flags flipped.
+func (s {{.Name}}Set) Toggle(values ...{{.Name}}) {{.Name}}Set {
+	for _, v := range values {
+		s.mask ^= v.Val()
+	}
+	return s
+}
+
+// Union returns the flags set in either s or other.
+func (s {{.Name}}Set) Union(other {{.Name}}Set) {{.Name}}Set {
+	return {{.Name}}Set{mask: s.mask | other.mask}
+}
+
+// Intersect returns the flags set in both s and other.
+func (s {{.Name}}Set) Intersect(other {{.Name}}Set) {{.Name}}Set {
+	return {{.Name}}Set{mask: s.mask & other.mask}
+}
+
+// Values returns the flags in the set in declaration order.
+func (s {{.Name}}Set) Values() iter.Seq[{{.Name}}] {
+	return enums.FlagValues({{.Name}}{}, s.mask)
+}
+
+// String returns the names of the flags joined by "|".
+func (s {{.Name}}Set) String() string {
+	return enums.FormatFlags({{.Name}}{}, s.mask)
+}
+
+{{- if .Options.SQL}}
+
+// Scan implements the database/sql.Scanner interface for {{.Name}}Set.
+func (s *{{.Name}}Set) Scan(value any) error {
+	mask, err := enums.FlagsSQLScan({{.Name}}{}, value)
+	if err != nil {
+		return err
+	}
+	s.mask = mask
+	return nil
+}
+
+// Value implements the database/sql/driver.Valuer interface for {{.Name}}Set.
+func (s {{.Name}}Set) Value() (driver.Value, error) {
+	return enums.FlagsSQLValue({{.Name}}{}, s.mask)
+}
+{{- end}}
+
+{{- if .Options.JSON}}
+
+// MarshalJSON implements the json.Marshaler interface for {{.Name}}Set.
+func (s {{.Name}}Set) MarshalJSON() ([]byte, error) {
+	return enums.MarshalFlagsJSON({{.Name}}{}, s.mask)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for {{.Name}}Set.
+func (s *{{.Name}}Set) UnmarshalJSON(data []byte) error {
+	mask, err := enums.UnmarshalFlagsJSON({{.Name}}{}, data)
+	if err != nil {
+		return err
+	}
+	s.mask = mask
+	return nil
+}
+{{- end}}
+
+{{- if .Options.Text}}
+
+// MarshalText implements the encoding.TextMarshaler interface for {{.Name}}Set.
+func (s {{.Name}}Set) MarshalText() ([]byte, error) {
+	return enums.MarshalFlagsText({{.Name}}{}, s.mask)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for {{.Name}}Set.
+func (s *{{.Name}}Set) UnmarshalText(data []byte) error {
+	mask, err := enums.UnmarshalFlagsText({{.Name}}{}, data)
+	if err != nil {
+		return err
+	}
+	s.mask = mask
+	return nil
+}
+{{- end}}
+{{- end}}
+
 {{- if .Options.StateMachine}}
 
 // CanTransitionTo checks if the current state can transition to the target state.
@@ -904,6 +1781,310 @@ func (t {{.Name}}) TerminalStateSlice() []{{.Name}} {
 		{{- end}}
 	}
 }
+
+// New{{.Name}}Machine returns a state machine that starts in initial, only
+// allows the transitions of {{.Name}} and is safe for concurrent use.
+func New{{.Name}}Machine(initial {{.Name}}) *enums.Machine[{{.BaseType}}, {{.Name}}] {
+	return enums.NewMachine[{{.BaseType}}](initial)
+}
+
+// {{.Name}}History records the transitions of a {{.Name}} state machine, see
+// enums.Machine.Record.
+type {{.Name}}History = enums.History[{{.BaseType}}, {{.Name}}]
+
+// IsInitialState returns true if this state is an initial state.
+func (t {{.Name}}) IsInitialState() bool {
+	{{- range .Values}}
+	{{- if and .IsInitial (not .IsInvalid)}}
+	if t == {{$enum.ContainerName}}.{{FirstUpper .Name}} {
+		return true
+	}
+	{{- end}}
+	{{- end}}
+	return false
+}
+
+// InitialStates returns a slice of all initial states.
+func (t {{.Name}}) InitialStates() []{{.Name}} {
+	return []{{.Name}}{
+		{{- range .Values}}
+		{{- if and .IsInitial (not .IsInvalid)}}
+		{{$enum.ContainerName}}.{{FirstUpper .Name}},
+		{{- end}}
+		{{- end}}
+	}
+}
+
+{{- if .Hierarchical}}
+
+// {{ToLower .Name}}ParentMap maps each substate to its parent state
+var {{ToLower .Name}}ParentMap = map[{{.Name}}]{{.Name}}{
+	{{- range .Values}}
+	{{- if and .Parent (not .IsInvalid)}}
+	{{$enum.ContainerName}}.{{FirstUpper .Name}}: {{$enum.ContainerName}}.{{FirstUpper .Parent}},
+	{{- end}}
+	{{- end}}
+}
+
+// {{ToLower .Name}}ChildrenMap lists the direct substates of each parent state
+var {{ToLower .Name}}ChildrenMap = map[{{.Name}}][]{{.Name}}{
+	{{- range .Values}}
+	{{- if not .IsInvalid}}
+	{{- $children := Children $enum .Name}}
+	{{- if $children}}
+	{{$enum.ContainerName}}.{{FirstUpper .Name}}: {
+		{{- range $children}}
+		{{$enum.ContainerName}}.{{FirstUpper .}},
+		{{- end}}
+	},
+	{{- end}}
+	{{- end}}
+	{{- end}}
+}
+
+// Parent returns the parent state of this state, or false if it has none.
+func (t {{.Name}}) Parent() ({{.Name}}, bool) {
+	parent, ok := {{ToLower .Name}}ParentMap[t]
+	return parent, ok
+}
+
+// IsIn returns true if this state is ancestor or one of its substates,
+// directly or through intermediate parents.
+func (t {{.Name}}) IsIn(ancestor {{.Name}}) bool {
+	for state, ok := t, true; ok; state, ok = {{ToLower .Name}}ParentMap[state] {
+		if state == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+// Children returns the direct substates of this state, in declaration order.
+func (t {{.Name}}) Children() []{{.Name}} {
+	return append([]{{.Name}}(nil), {{ToLower .Name}}ChildrenMap[t]...)
+}
+{{- end}}
+{{- $reach := Reachability $enum}}
+
+// {{ToLower .Name}}ReachableMap lists the states reachable from each state with one or more transitions
+var {{ToLower .Name}}ReachableMap = map[{{.Name}}][]{{.Name}}{
+	{{- range $reach}}
+	{{- if .Reachable}}
+	{{$enum.ContainerName}}.{{FirstUpper .State}}: {
+		{{- range .Reachable}}
+		{{$enum.ContainerName}}.{{FirstUpper .}},
+		{{- end}}
+	},
+	{{- end}}
+	{{- end}}
+}
+
+// {{ToLower .Name}}SourceMap lists the states that can transition to each state
+var {{ToLower .Name}}SourceMap = map[{{.Name}}][]{{.Name}}{
+	{{- range $target := .Values}}
+	{{- if not .IsInvalid}}
+	{{- $sources := Sources $enum .Name}}
+	{{- if $sources}}
+	{{$enum.ContainerName}}.{{FirstUpper .Name}}: {
+		{{- range $sources}}
+		{{$enum.ContainerName}}.{{FirstUpper .}},
+		{{- end}}
+	},
+	{{- end}}
+	{{- end}}
+	{{- end}}
+}
+
+// SourceStates returns the states that can transition to this state, in declaration order.
+func (t {{.Name}}) SourceStates() []{{.Name}} {
+	return append([]{{.Name}}(nil), {{ToLower .Name}}SourceMap[t]...)
+}
+
+{{- if .Options.SQL}}
+
+// Update{{.Name}} sets the state of the row identified by id with a single
+// conditional UPDATE that only matches rows whose current state can
+// transition to the new state. It returns false if no row was updated.
+func Update{{.Name}}(ctx context.Context, db enums.Execer, u enums.StateUpdate, id any, to {{.Name}}) (bool, error) {
+	return enums.UpdateState(ctx, db, u, id, to, to.SourceStates())
+}
+{{- end}}
+
+// CanReach returns true if target can be reached from this state with zero or more transitions.
+func (t {{.Name}}) CanReach(target {{.Name}}) bool {
+	if t == target {
+		return true
+	}
+	for _, state := range {{ToLower .Name}}ReachableMap[t] {
+		if state == target {
+			return true
+		}
+	}
+	return false
+}
+
+// ShortestPath returns the states of a shortest path from this state to target,
+// starting with this state and ending with target. It returns nil if target
+// cannot be reached. Transitions are preferred in the order they are declared.
+func (t {{.Name}}) ShortestPath(target {{.Name}}) []{{.Name}} {
+	if t == target {
+		return []{{.Name}}{t}
+	}
+	if !t.CanReach(target) {
+		return nil
+	}
+	// Breadth-first search, remembering the state each state was reached from
+	prev := map[{{.Name}}]{{.Name}}{t: t}
+	queue := []{{.Name}}{t}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range current.ValidTransitions() {
+			if _, seen := prev[next]; seen || !next.IsValid() {
+				continue
+			}
+			prev[next] = current
+			if next != target {
+				queue = append(queue, next)
+				continue
+			}
+			var path []{{.Name}}
+			for state := target; state != t; state = prev[state] {
+				path = append(path, state)
+			}
+			path = append(path, t)
+			for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+				path[i], path[j] = path[j], path[i]
+			}
+			return path
+		}
+	}
+	return nil
+}
+
+// ReachableStates returns the states that can be reached from this state with
+// one or more transitions, in declaration order.
+func (t {{.Name}}) ReachableStates() []{{.Name}} {
+	return append([]{{.Name}}(nil), {{ToLower .Name}}ReachableMap[t]...)
+}
+
+{{- if .Events}}
+
+// {{.Name}}Event is an event that triggers a transition of {{.Name}}.
+type {{.Name}}Event string
+
+// Events of the {{.Name}} state machine.
+const (
+	{{- range .Events}}
+	{{$enum.Name}}Event{{FirstUpper .}} {{$enum.Name}}Event = "{{.}}"
+	{{- end}}
+)
+
+// {{.Name}}Events returns all events of the {{.Name}} state machine.
+func {{.Name}}Events() []{{.Name}}Event {
+	return []{{.Name}}Event{
+		{{- range .Events}}
+		{{$enum.Name}}Event{{FirstUpper .}},
+		{{- end}}
+	}
+}
+
+// IsValid returns true if the event is declared in the {{.Name}} state machine.
+func (e {{.Name}}Event) IsValid() bool {
+	switch e {
+	case {{range $i, $e := .Events}}{{if $i}}, {{end}}{{$enum.Name}}Event{{FirstUpper $e}}{{end}}:
+		return true
+	}
+	return false
+}
+
+// String implements the Stringer interface.
+func (e {{.Name}}Event) String() string {
+	return string(e)
+}
+
+// {{ToLower .Name}}EventMap maps each state and event to the target state
+var {{ToLower .Name}}EventMap = map[{{.Name}}]map[{{.Name}}Event]{{.Name}}{
+	{{- range .Values}}
+	{{- if and .Events (not .IsInvalid)}}
+	{{$enum.ContainerName}}.{{FirstUpper .Name}}: {
+		{{- range .Events}}
+		{{$enum.Name}}Event{{FirstUpper .Event}}: {{$enum.ContainerName}}.{{FirstUpper .Target}},
+		{{- end}}
+	},
+	{{- end}}
+	{{- end}}
+}
+
+// Fire returns the state that event leads to from this state. It returns an
+// *enums.EventError if the event is not available in this state.
+func (t {{.Name}}) Fire(event {{.Name}}Event) ({{.Name}}, error) {
+	if target, ok := {{ToLower .Name}}EventMap[t][event]; ok {
+		return target, nil
+	}
+	return t, &enums.EventError{Type: "{{.Name}}", State: t.String(), Event: string(event)}
+}
+
+// AvailableEvents returns the events that can be fired in this state.
+func (t {{.Name}}) AvailableEvents() []{{.Name}}Event {
+	{{- range .Values}}
+	{{- if and .Events (not .IsInvalid)}}
+	if t == {{$enum.ContainerName}}.{{FirstUpper .Name}} {
+		return []{{$enum.Name}}Event{
+			{{- range .Events}}
+			{{$enum.Name}}Event{{FirstUpper .Event}},
+			{{- end}}
+		}
+	}
+	{{- end}}
+	{{- end}}
+	return []{{.Name}}Event{}
+}
+{{- end}}
+
+// {{.Name}}TransitionTable describes the valid states of {{.Name}} and their
+// transitions, e.g. for export as JSON. States are referred to by name.
+func {{.Name}}TransitionTable() enums.TransitionTable {
+	return enums.TransitionTable{
+		Type: "{{.Name}}",
+		States: []enums.StateEntry{
+			{{- range .Values}}
+			{{- if not .IsInvalid}}
+			{
+				Name: "{{index .Names 0}}",
+				{{- if gt (len .Names) 1}}
+				Aliases: []string{ {{- range $i, $n := slice .Names 1}}{{if $i}}, {{end}}"{{$n}}"{{end -}} },
+				{{- end}}
+				Value: {{$enum.ContainerName}}.{{FirstUpper .Name}}.Val(),
+				{{- if .Tags}}
+				Tags: []string{ {{- range $i, $t := .Tags}}{{if $i}}, {{end}}"{{$t}}"{{end -}} },
+				{{- end}}
+				{{- if .Parent}}
+				Parent: "{{StateName $enum .Parent}}",
+				{{- end}}
+				Initial: {{.IsInitial}},
+				Final: {{.IsFinal}},
+				Transitions: []string{ {{- range $i, $t := ValidTargets $enum .}}{{if $i}}, {{end}}"{{StateName $enum $t}}"{{end -}} },
+				{{- if .Events}}
+				Events: []enums.EventEntry{
+					{{- range .Events}}
+					{Event: "{{.Event}}", Target: "{{StateName $enum .Target}}"},
+					{{- end}}
+				},
+				{{- end}}
+			},
+			{{- end}}
+			{{- end}}
+		},
+	}
+}
+
+// {{.Name}}StateDiagram returns the state machine of {{.Name}} as a Mermaid
+// state diagram. Final states are styled with the "final" class and invalid
+// states are omitted. Use goenum -graph=dot for Graphviz output.
+func {{.Name}}StateDiagram() string {
+	return {{StateDiagram $enum}}
+}
 {{- end}}
 {{end}}
 `
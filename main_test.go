@@ -0,0 +1,119 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// writeFiles 在 dir 下写入文件，键为相对路径
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// parseFiles 将 files 写入临时目录中的包并解析其中的枚举
+func parseFiles(t *testing.T, files map[string]string) []EnumInfo {
+	t.Helper()
+	dir := t.TempDir()
+	writeFiles(t, dir, files)
+	pkg, err := loadPackage(dir)
+	if err != nil {
+		t.Fatalf("loadPackage failed: %v", err)
+	}
+	enums, err := parsePackage(pkg)
+	if err != nil {
+		t.Fatalf("parsePackage failed: %v", err)
+	}
+	if len(enums) == 0 {
+		t.Fatal("no enums found")
+	}
+	return enums
+}
+
+// parseSource 解析只包含 src 一个文件的包
+func parseSource(t *testing.T, src string) []EnumInfo {
+	t.Helper()
+	return parseFiles(t, map[string]string{"enums.go": src})
+}
+
+// messages 返回诊断信息的文本，不含位置
+func messages(diags []Diagnostic) []string {
+	var result []string
+	for _, d := range diags {
+		result = append(result, d.Message)
+	}
+	return result
+}
+
+func TestStringValues(t *testing.T) {
+	src := `package order
+
+const prefix = "kind."
+
+// goenums: -json
+type kind string
+
+const (
+	// Literal
+	literal kind = "a"
+	// Computed
+	computed kind = prefix + "b"
+	// Converted
+	converted kind = kind(prefix) + ` + "`c`" + `
+)
+`
+	enums := parseSource(t, src)
+	// 字符串常量无论是字面量还是计算得到的，都保留带引号的值
+	var got []string
+	for _, v := range enums[0].Values {
+		got = append(got, v.Value)
+	}
+	if want := []string{`"a"`, `"kind.b"`, `"kind.c"`}; !slices.Equal(got, want) {
+		t.Fatalf("values = %v, want %v", got, want)
+	}
+	if diags := validateEnums(enums); len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+
+	out := filepath.Join(t.TempDir(), "order_enums.go")
+	if err := generateEnumsFile(enums, out); err != nil {
+		t.Fatalf("generateEnumsFile failed: %v", err)
+	}
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{`// Literal ("a")`, `// Computed ("kind.b")`, `// Converted ("kind.c")`} {
+		if !strings.Contains(string(data), line) {
+			t.Errorf("generated file does not contain %s", line)
+		}
+	}
+
+	// 无法求值的字符串常量报告错误，而不是生成整数
+	enums = parseSource(t, `package order
+
+import "example.com/missing/names"
+
+// goenums: -json
+type kind string
+
+const (
+	literal kind = "a"
+	computed kind = names.Prefix + "b"
+)
+`)
+	if got := messages(validateEnums(enums)); !slices.Equal(got, []string{"kind: cannot evaluate the value of computed"}) {
+		t.Errorf("diagnostics = %q", got)
+	}
+}
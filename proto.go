@@ -0,0 +1,236 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// protoEnum is the protobuf definition of an enum with -proto.
+type protoEnum struct {
+	Name   string       // Name of the protobuf enum
+	GoType string       // Qualified generated Go type, if -proto names one
+	Values []protoValue // The zero value first, then the valid values in declaration order
+}
+
+// protoValue is a value of a protobuf enum.
+type protoValue struct {
+	Name    string // Value name prefixed with the enum name, e.g. ORDER_STATUS_PENDING
+	Number  int64
+	Const   string // Constant the value is converted from, the invalid one for the zero value
+	GoConst string // Qualified generated Go constant, if -proto names a type
+	Zero    bool
+}
+
+// protoGoType splits the value of -proto=<import path>.<Type>.
+func protoGoType(option string) (path, typeName string, ok bool) {
+	i := strings.LastIndex(option, ".")
+	if i <= 0 || !token.IsIdentifier(option[i+1:]) {
+		return "", "", false
+	}
+	return option[:i], option[i+1:], true
+}
+
+var versionElem = regexp.MustCompile(`^v[0-9]+`)
+
+// templateImports are the names of the packages the generated file may
+// import, which protobuf packages must not be imported as.
+var templateImports = []string{"context", "driver", "xml", "fmt", "enums", "iter", "strconv", "strings", "yaml"}
+
+// protoImportAlias returns the name the protobuf Go package at path is
+// imported as. Version elements are joined with their parent, as in
+// acme/order/v1 -> orderv1, following the buf convention.
+func protoImportAlias(path string) string {
+	elems := strings.Split(path, "/")
+	alias := elems[len(elems)-1]
+	if versionElem.MatchString(alias) && len(elems) > 1 {
+		alias = elems[len(elems)-2] + alias
+	}
+	alias = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, alias)
+	if alias == "" || !token.IsIdentifier(alias) {
+		alias = "pb" + alias
+	}
+	return alias
+}
+
+// assignProtoImports imports every protobuf package of enums once, under a
+// name that is unique and not taken by the packages the template imports,
+// and sets the ProtoAlias of the enums.
+func assignProtoImports(enums []EnumInfo) []ProtoImport {
+	var protoImports []ProtoImport
+	taken := func(alias string) bool {
+		return slices.Contains(templateImports, alias) ||
+			slices.ContainsFunc(protoImports, func(imp ProtoImport) bool { return imp.Alias == alias })
+	}
+	for i := range enums {
+		path, _, ok := protoGoType(enums[i].Options.ProtoType)
+		if !ok {
+			continue
+		}
+		alias := ""
+		for _, imp := range protoImports {
+			if imp.Path == path {
+				alias = imp.Alias
+			}
+		}
+		if alias == "" {
+			alias = protoImportAlias(path)
+			for n := 2; taken(alias); n++ {
+				alias = protoImportAlias(path) + strconv.Itoa(n)
+			}
+			protoImports = append(protoImports, ProtoImport{Alias: alias, Path: path})
+		}
+		enums[i].ProtoAlias = alias
+	}
+	return protoImports
+}
+
+// upperSnake converts an identifier to UPPER_SNAKE_CASE.
+func upperSnake(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if b.Len() > 0 && !strings.HasSuffix(b.String(), "_") {
+				b.WriteByte('_')
+			}
+			continue
+		}
+		if unicode.IsUpper(r) && i > 0 && b.Len() > 0 && !strings.HasSuffix(b.String(), "_") {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || unicode.IsUpper(prev) && nextLower {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return strings.TrimSuffix(b.String(), "_")
+}
+
+// protoEnumOf builds the protobuf definition of enum. Values of integer
+// enums keep their number, other enums are numbered in declaration order
+// starting at 1.
+func protoEnumOf(enum *EnumInfo) protoEnum {
+	p := protoEnum{Name: enum.Name}
+	_, typeName, hasType := protoGoType(enum.Options.ProtoType)
+	if hasType {
+		p.Name = typeName
+		p.GoType = enum.ProtoAlias + "." + typeName
+	}
+	prefix := upperSnake(p.Name) + "_"
+	value := func(suffix string) protoValue {
+		v := protoValue{Name: prefix + strings.TrimPrefix(suffix, prefix)}
+		if hasType {
+			v.GoConst = p.GoType + "_" + v.Name
+		}
+		return v
+	}
+
+	zero := value("UNSPECIFIED")
+	zero.Zero = true
+	for _, v := range enum.Values {
+		if v.IsInvalid {
+			zero.Const = v.Name
+			break
+		}
+	}
+	p.Values = append(p.Values, zero)
+
+	for _, v := range enum.Values {
+		if v.IsInvalid {
+			continue
+		}
+		pv := value(upperSnake(v.Name))
+		pv.Const = v.Name
+		if integerBaseTypes[enum.BaseType] {
+			pv.Number, _ = strconv.ParseInt(v.Value, 0, 64)
+		} else {
+			pv.Number = int64(len(p.Values))
+		}
+		p.Values = append(p.Values, pv)
+	}
+	return p
+}
+
+// checkProto reports enums whose values cannot be represented as a
+// protobuf enum.
+func checkProto(enum *EnumInfo) []Diagnostic {
+	var diags []Diagnostic
+	report := func(pos token.Position, format string, args ...any) {
+		diags = append(diags, Diagnostic{Pos: pos, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if enum.Options.ProtoType != "" {
+		if _, _, ok := protoGoType(enum.Options.ProtoType); !ok {
+			report(enum.Pos, "%s: -proto=%s must be <import path>.<Type>", enum.Type, enum.Options.ProtoType)
+		}
+	}
+
+	seen := make(map[string]string)
+	p := protoEnumOf(enum)
+	for _, pv := range p.Values[1:] {
+		v := findState(enum, pv.Const)
+		if integerBaseTypes[enum.BaseType] && !v.Unevaluated {
+			n, err := strconv.ParseInt(v.Value, 0, 32)
+			switch {
+			case err != nil:
+				report(v.Pos, "%s: value %s of %s does not fit in a protobuf enum", enum.Type, v.Value, v.Name)
+			case n == 0:
+				report(v.Pos, "%s: %s has value 0, which protobuf reserves for the zero value; mark it invalid or change its value", enum.Type, v.Name)
+			}
+		}
+		if other, ok := seen[pv.Name]; ok {
+			report(v.Pos, "%s: %s and %s both map to the protobuf value %s", enum.Type, other, v.Name, pv.Name)
+			continue
+		}
+		seen[pv.Name] = v.Name
+	}
+	if other, ok := seen[p.Values[0].Name]; ok {
+		report(enum.Pos, "%s: %s maps to the protobuf zero value %s", enum.Type, other, p.Values[0].Name)
+	}
+	return diags
+}
+
+// renderProtoEnum returns the protobuf definition of enum.
+func renderProtoEnum(enum *EnumInfo) string {
+	p := protoEnumOf(enum)
+	var b strings.Builder
+	fmt.Fprintf(&b, "// %s is generated by goenum from %s.\n", p.Name, enum.Type)
+	fmt.Fprintf(&b, "enum %s {\n", p.Name)
+	for _, v := range p.Values {
+		fmt.Fprintf(&b, "  %s = %d;", v.Name, v.Number)
+		if v.Zero && v.Const != "" {
+			fmt.Fprintf(&b, " // %s", v.Const)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// printProtoEnums writes the protobuf definitions of every -proto enum to
+// stdout.
+func printProtoEnums(enums []EnumInfo) {
+	first := true
+	for i := range enums {
+		if !enums[i].Options.Proto {
+			continue
+		}
+		if !first {
+			fmt.Fprintln(os.Stdout)
+		}
+		first = false
+		fmt.Fprint(os.Stdout, renderProtoEnum(&enums[i]))
+	}
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"go/ast"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestUpperSnake(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"OrderStatus", "ORDER_STATUS"},
+		{"onHold", "ON_HOLD"},
+		{"HTTPStatus", "HTTP_STATUS"},
+		{"on_hold", "ON_HOLD"},
+		{"v2Item", "V2_ITEM"},
+		{"ID", "ID"},
+		{"pending", "PENDING"},
+		{"_private_", "PRIVATE"},
+	}
+	for _, tt := range tests {
+		if got := upperSnake(tt.in); got != tt.want {
+			t.Errorf("upperSnake(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestProtoImportAlias(t *testing.T) {
+	tests := []struct {
+		path, want string
+	}{
+		{"example.com/gen/order/v1", "orderv1"},
+		{"example.com/gen/orderpb", "orderpb"},
+		{"example.com/gen/order-pb", "orderpb"},
+		{"v1", "v1"},
+		{"example.com/gen/1st", "pb1st"},
+	}
+	for _, tt := range tests {
+		if got := protoImportAlias(tt.path); got != tt.want {
+			t.Errorf("protoImportAlias(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+
+	// 模板可能导入的每个包名都不能用作别名，包名取自模板本身
+	block, _, _ := strings.Cut(fileTemplate[strings.Index(fileTemplate, "import ("):], ")")
+	var names []string
+	for _, m := range regexp.MustCompile(`(?m)^\s*"([^"]+)"$`).FindAllStringSubmatch(block, -1) {
+		names = append(names, importName(&ast.ImportSpec{}, m[1]))
+	}
+	if !slices.Contains(names, "strings") || !slices.Contains(names, "yaml") {
+		t.Fatalf("imports of the template not found: %v", names)
+	}
+	for _, name := range names {
+		enums := []EnumInfo{{Options: EnumOptions{ProtoType: "example.com/gen/" + name + ".T"}}}
+		imports := assignProtoImports(enums)
+		if len(imports) != 1 || imports[0].Alias != name+"2" || enums[0].ProtoAlias != name+"2" {
+			t.Errorf("package %s imported as %v, want %s2", name, imports, name)
+		}
+	}
+}
+
+func TestProtoEnumOf(t *testing.T) {
+	t.Run("整数枚举", func(t *testing.T) {
+		enum := &parseSource(t, `package order
+
+// goenums: -proto=example.com/gen/order/v1.OrderStatus
+type status int
+
+const (
+	// invalid
+	unknown status = iota
+	pending
+	onHold
+	done status = 10
+)
+`)[0]
+		enum.ProtoAlias = "orderv1"
+		p := protoEnumOf(enum)
+		if p.Name != "OrderStatus" || p.GoType != "orderv1.OrderStatus" {
+			t.Errorf("protoEnumOf = %s, %s", p.Name, p.GoType)
+		}
+		// 整数枚举保留原值，零值对应无效值
+		want := []protoValue{
+			{Name: "ORDER_STATUS_UNSPECIFIED", Number: 0, Const: "unknown", GoConst: "orderv1.OrderStatus_ORDER_STATUS_UNSPECIFIED", Zero: true},
+			{Name: "ORDER_STATUS_PENDING", Number: 1, Const: "pending", GoConst: "orderv1.OrderStatus_ORDER_STATUS_PENDING"},
+			{Name: "ORDER_STATUS_ON_HOLD", Number: 2, Const: "onHold", GoConst: "orderv1.OrderStatus_ORDER_STATUS_ON_HOLD"},
+			{Name: "ORDER_STATUS_DONE", Number: 10, Const: "done", GoConst: "orderv1.OrderStatus_ORDER_STATUS_DONE"},
+		}
+		if !slices.Equal(p.Values, want) {
+			t.Errorf("values = %+v, want %+v", p.Values, want)
+		}
+	})
+
+	t.Run("字符串枚举", func(t *testing.T) {
+		enum := &parseSource(t, `package color
+
+// goenums: -proto
+type color string
+
+const (
+	red color = "r"
+	// 已带前缀的常量不重复添加前缀
+	colorGreen color = "g"
+)
+`)[0]
+		p := protoEnumOf(enum)
+		// 字符串枚举按声明顺序从 1 开始编号
+		want := []protoValue{
+			{Name: "COLOR_UNSPECIFIED", Number: 0, Zero: true},
+			{Name: "COLOR_RED", Number: 1, Const: "red"},
+			{Name: "COLOR_GREEN", Number: 2, Const: "colorGreen"},
+		}
+		if p.Name != "Color" || p.GoType != "" || !slices.Equal(p.Values, want) {
+			t.Errorf("protoEnumOf = %s, %q, %+v, want Color, %+v", p.Name, p.GoType, p.Values, want)
+		}
+	})
+}
+
+func TestCheckProto(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []string
+	}{
+		{
+			name: "零值",
+			src: `
+// goenums: -proto
+type status int
+
+const (
+	pending status = iota
+	done
+)
+`,
+			want: []string{"status: pending has value 0, which protobuf reserves for the zero value; mark it invalid or change its value"},
+		},
+		{
+			name: "超出int32",
+			src: `
+// goenums: -proto
+type status int64
+
+const (
+	pending status = 1
+	done    status = 1 << 40
+)
+`,
+			want: []string{"status: value 1099511627776 of done does not fit in a protobuf enum"},
+		},
+		{
+			name: "名称冲突",
+			src: `
+// goenums: -proto
+type status string
+
+const (
+	onHold status = "a"
+	on_hold status = "b"
+	unspecified status = "c"
+)
+`,
+			want: []string{
+				"status: onHold and on_hold both map to the protobuf value STATUS_ON_HOLD",
+				"status: unspecified maps to the protobuf zero value STATUS_UNSPECIFIED",
+			},
+		},
+		{
+			name: "缺少类型",
+			src: `
+// goenums: -proto=example.com/gen/order
+type status string
+
+const (
+	pending status = "p"
+)
+`,
+			want: []string{"status: -proto=example.com/gen/order must be <import path>.<Type>"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enums := parseSource(t, "package order\n"+tt.src)
+			if got := messages(validateEnums(enums)); !slices.Equal(got, tt.want) {
+				t.Errorf("diagnostics = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProtoImports(t *testing.T) {
+	enums := parseSource(t, `package order
+
+// goenums: -proto=example.com/gen/fmt.Status
+type status int
+
+const (
+	// invalid
+	unknown status = iota
+	pending
+)
+
+// goenums: -proto=example.com/gen/fmt.Kind
+type kind int
+
+const (
+	// invalid
+	noKind kind = iota
+	small
+)
+
+// goenums: -proto=example.com/other/fmt.Color
+type color int
+
+const (
+	// invalid
+	noColor color = iota
+	red
+)
+`)
+	out := filepath.Join(t.TempDir(), "enums_gen.go")
+	if err := generateEnumsFile(enums, out); err != nil {
+		t.Fatalf("generateEnumsFile failed: %v", err)
+	}
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 与模板导入的 fmt 冲突时添加序号，同一路径只导入一次
+	for _, imp := range []string{`fmt2 "example.com/gen/fmt"`, `fmt3 "example.com/other/fmt"`} {
+		if strings.Count(string(data), imp) != 1 {
+			t.Errorf("expected the import %s once", imp)
+		}
+	}
+	if got := []string{enums[0].ProtoAlias, enums[1].ProtoAlias, enums[2].ProtoAlias}; !slices.Equal(got, []string{"fmt2", "fmt2", "fmt3"}) {
+		t.Errorf("aliases = %v", got)
+	}
+}
@@ -0,0 +1,394 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"slices"
+	"strings"
+)
+
+// stateMachine is the transition graph of the valid values of an enum.
+// Invalid states and transitions into them or to unknown targets are dropped.
+type stateMachine struct {
+	states []*EnumValue
+	index  map[string]int // Constant name to position in states
+	next   [][]int
+}
+
+func newStateMachine(enum *EnumInfo) *stateMachine {
+	sm := &stateMachine{index: make(map[string]int)}
+	for i := range enum.Values {
+		v := &enum.Values[i]
+		if v.IsInvalid {
+			continue
+		}
+		if _, ok := sm.index[v.Name]; ok {
+			continue
+		}
+		sm.index[v.Name] = len(sm.states)
+		sm.states = append(sm.states, v)
+	}
+	sm.next = make([][]int, len(sm.states))
+	for i, v := range sm.states {
+		for _, target := range v.Transitions {
+			if j, ok := sm.index[target]; ok {
+				sm.next[i] = append(sm.next[i], j)
+			}
+		}
+	}
+	return sm
+}
+
+// reachable returns which states can be reached from the given states along
+// edges, including the states themselves.
+func reachable(edges [][]int, from []int) []bool {
+	seen := make([]bool, len(edges))
+	queue := append([]int(nil), from...)
+	for _, i := range from {
+		seen[i] = true
+	}
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+		for _, j := range edges[i] {
+			if !seen[j] {
+				seen[j] = true
+				queue = append(queue, j)
+			}
+		}
+	}
+	return seen
+}
+
+// reverse returns the graph with all edges reversed.
+func (sm *stateMachine) reverse() [][]int {
+	prev := make([][]int, len(sm.states))
+	for i, targets := range sm.next {
+		for _, j := range targets {
+			prev[j] = append(prev[j], i)
+		}
+	}
+	return prev
+}
+
+// components returns the strongly connected components of the graph in
+// Tarjan's order, each sorted by declaration order.
+func (sm *stateMachine) components() [][]int {
+	n := len(sm.states)
+	index := make([]int, n)
+	low := make([]int, n)
+	onStack := make([]bool, n)
+	for i := range index {
+		index[i] = -1
+	}
+	var stack []int
+	var result [][]int
+	counter := 0
+
+	var visit func(i int)
+	visit = func(i int) {
+		index[i], low[i] = counter, counter
+		counter++
+		stack = append(stack, i)
+		onStack[i] = true
+		for _, j := range sm.next[i] {
+			if index[j] < 0 {
+				visit(j)
+				low[i] = min(low[i], low[j])
+			} else if onStack[j] {
+				low[i] = min(low[i], index[j])
+			}
+		}
+		if low[i] == index[i] {
+			var component []int
+			for {
+				j := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[j] = false
+				component = append(component, j)
+				if j == i {
+					break
+				}
+			}
+			slices.Sort(component)
+			result = append(result, component)
+		}
+	}
+	for i := range n {
+		if index[i] < 0 {
+			visit(i)
+		}
+	}
+	return result
+}
+
+// hasSelfLoop reports whether state i can transition to itself.
+func (sm *stateMachine) hasSelfLoop(i int) bool {
+	for _, j := range sm.next[i] {
+		if j == i {
+			return true
+		}
+	}
+	return false
+}
+
+func (sm *stateMachine) names(states []int) string {
+	names := make([]string, len(states))
+	for k, i := range states {
+		names[k] = sm.states[i].Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// checkStateMachine analyzes the transition graph of enum for states that
+// are unreachable from the initial states, dead ends, final states with
+// transitions and cycles that can never reach a final state.
+func checkStateMachine(enum *EnumInfo) []Diagnostic {
+	var diags []Diagnostic
+	report := func(pos token.Position, format string, args ...any) {
+		diags = append(diags, Diagnostic{Pos: pos, Message: fmt.Sprintf(format, args...)})
+	}
+
+	sm := newStateMachine(enum)
+
+	var initial, final []int
+	for i, v := range sm.states {
+		if v.IsInitial {
+			initial = append(initial, i)
+		}
+		if v.IsFinal {
+			final = append(final, i)
+		}
+	}
+
+	if len(initial) > 0 {
+		from := "the initial state " + sm.names(initial)
+		if len(initial) > 1 {
+			from = "the initial states " + sm.names(initial)
+		}
+		seen := reachable(sm.next, initial)
+		// A parent state is entered with any of its substates
+		for i, v := range sm.states {
+			if !seen[i] {
+				continue
+			}
+			for _, ancestor := range ancestors(enum, v.Name) {
+				if j, ok := sm.index[ancestor]; ok {
+					seen[j] = true
+				}
+			}
+		}
+		for i, v := range sm.states {
+			if !seen[i] {
+				report(v.StatePos, "%s: state %s is unreachable from %s", enum.Type, v.Name, from)
+			}
+		}
+	}
+
+	for i, v := range sm.states {
+		switch {
+		case v.IsFinal && len(v.Transitions) > 0:
+			report(v.StatePos, "%s: final state %s declares transitions", enum.Type, v.Name)
+		case !v.IsFinal && len(sm.next[i]) == 0 && len(childStates(enum, v.Name)) == 0:
+			// A parent that only groups substates is left through them
+			report(v.StatePos, "%s: state %s has no transitions and is not marked [final]", enum.Type, v.Name)
+		}
+	}
+
+	// Single states without a self loop are dead ends, which are reported above
+	canFinish := reachable(sm.reverse(), final)
+	components := sm.components()
+	for k := len(components) - 1; k >= 0; k-- {
+		component := components[k]
+		first := sm.states[component[0]]
+		switch {
+		case canFinish[component[0]]:
+		case len(component) > 1:
+			report(first.StatePos, "%s: states %s form a cycle that can never reach a final state",
+				enum.Type, sm.names(component))
+		case sm.hasSelfLoop(component[0]):
+			report(first.StatePos, "%s: state %s only transitions to itself and can never reach a final state",
+				enum.Type, first.Name)
+		}
+	}
+
+	return diags
+}
+
+// StateReach is the precomputed reachability of a state, rendered into a
+// static table by the template.
+type StateReach struct {
+	State     string   // Constant name of the state
+	Reachable []string // States reachable with one or more transitions, in declaration order
+}
+
+// reachability computes the StateReach of every valid state of enum.
+func reachability(enum *EnumInfo) []StateReach {
+	sm := newStateMachine(enum)
+	result := make([]StateReach, len(sm.states))
+	for i, v := range sm.states {
+		result[i].State = v.Name
+		seen := reachable(sm.next, sm.next[i])
+		for j, ok := range seen {
+			if ok {
+				result[i].Reachable = append(result[i].Reachable, sm.states[j].Name)
+			}
+		}
+	}
+	return result
+}
+
+// isStateSelector reports whether s selects several states: "*" for every
+// non-final state or "tag:x" for every state tagged x.
+func isStateSelector(s string) bool {
+	return s == "*" || strings.HasPrefix(s, "tag:")
+}
+
+// selectStates returns the valid states matched by selector, except the
+// state named exclude. Final states only match "tag:x" selectors.
+func selectStates(enum *EnumInfo, selector string, exclude string) []string {
+	tag, byTag := strings.CutPrefix(selector, "tag:")
+	var names []string
+	for _, v := range enum.Values {
+		if v.IsInvalid || v.Name == exclude {
+			continue
+		}
+		if byTag && slices.Contains(v.Tags, strings.TrimSpace(tag)) || !byTag && !v.IsFinal {
+			names = append(names, v.Name)
+		}
+	}
+	return names
+}
+
+// expandTransitions replaces wildcard targets with the states they select
+// and adds the transitions of wildcard rules to every selected source state.
+// Wildcards are kept in Selectors for validation. Finally every non-final
+// state inherits the transitions and events of its ancestors.
+func expandTransitions(enum *EnumInfo) {
+	addTransition := func(v *EnumValue, target string) {
+		if !slices.Contains(v.Transitions, target) {
+			v.Transitions = append(v.Transitions, target)
+		}
+	}
+
+	for i := range enum.Values {
+		v := &enum.Values[i]
+		transitions := v.Transitions
+		v.Transitions = nil
+		for _, target := range transitions {
+			if !isStateSelector(target) {
+				addTransition(v, target)
+				continue
+			}
+			if !slices.Contains(v.Selectors, target) {
+				v.Selectors = append(v.Selectors, target)
+			}
+			for _, name := range selectStates(enum, target, v.Name) {
+				addTransition(v, name)
+			}
+		}
+	}
+
+	index := make(map[string]int)
+	for i, v := range enum.Values {
+		index[v.Name] = i
+	}
+	for _, v := range enum.Values {
+		for _, rule := range v.Rules {
+			if _, ok := index[rule.Target]; !ok {
+				continue // Reported by validateEnum
+			}
+			for _, name := range selectStates(enum, rule.Source, rule.Target) {
+				if source := &enum.Values[index[name]]; !source.IsFinal {
+					addTransition(source, rule.Target)
+				}
+			}
+		}
+	}
+
+	// Inherit from a snapshot, so the result does not depend on the order
+	// in which parents and children are declared
+	own := make([]EnumValue, len(enum.Values))
+	copy(own, enum.Values)
+	for i := range enum.Values {
+		v := &enum.Values[i]
+		if v.IsFinal {
+			continue
+		}
+		for _, ancestor := range ancestors(enum, v.Name) {
+			parent := own[index[ancestor]]
+			// An event handled by the child overrides the event of the parent
+			handled := make(map[string]bool)
+			for _, e := range v.Events {
+				handled[e.Event] = true
+			}
+			inherited := make(map[string]bool)
+			named := make(map[string]bool)
+			for _, e := range parent.Events {
+				named[e.Target] = true
+				if !handled[e.Event] && e.Target != v.Name {
+					inherited[e.Target] = true
+					v.Events = append(v.Events, e)
+				}
+			}
+			for _, target := range parent.Transitions {
+				if target != v.Name && (!named[target] || inherited[target]) {
+					addTransition(v, target)
+				}
+			}
+		}
+	}
+}
+
+// ancestors returns the parent of the state named name, its parent and so
+// on, nearest first. It stops at unknown parents and cycles.
+func ancestors(enum *EnumInfo, name string) []string {
+	var result []string
+	seen := map[string]bool{name: true}
+	for {
+		value := findState(enum, name)
+		if value == nil || value.Parent == "" || seen[value.Parent] || findState(enum, value.Parent) == nil {
+			return result
+		}
+		name = value.Parent
+		seen[name] = true
+		result = append(result, name)
+	}
+}
+
+// findState returns the value of the constant named name.
+func findState(enum *EnumInfo, name string) *EnumValue {
+	for i := range enum.Values {
+		if enum.Values[i].Name == name {
+			return &enum.Values[i]
+		}
+	}
+	return nil
+}
+
+// childStates returns the valid states whose parent is the state named
+// parent, in declaration order.
+func childStates(enum *EnumInfo, parent string) []string {
+	var children []string
+	for _, v := range enum.Values {
+		if v.Parent == parent && !v.IsInvalid {
+			children = append(children, v.Name)
+		}
+	}
+	return children
+}
+
+// sourceStates returns the valid states that can transition to target, in
+// declaration order.
+func sourceStates(enum *EnumInfo, target string) []string {
+	sm := newStateMachine(enum)
+	j, ok := sm.index[target]
+	if !ok {
+		return nil
+	}
+	var sources []string
+	for _, i := range sm.reverse()[j] {
+		sources = append(sources, sm.states[i].Name)
+	}
+	return sources
+}
@@ -0,0 +1,428 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+// stateSource 返回包含 order 状态机的源码，consts 为常量块内容
+func stateSource(consts string) string {
+	return "package order\n\n// goenums: -statemachine/strict\ntype order int\n\nconst (\n" + consts + ")\n"
+}
+
+func TestCheckStateMachine(t *testing.T) {
+	tests := []struct {
+		name   string
+		consts string
+		want   []string
+	}{
+		{
+			name: "正常",
+			consts: `
+	// state: [initial] -> paid, canceled
+	created order = iota
+	// state: -> delivered
+	paid
+	// state: [final]
+	delivered
+	// state: [final]
+	canceled
+`,
+		},
+		{
+			name: "不可达状态",
+			consts: `
+	// state: [initial] -> delivered
+	created order = iota
+	// state: -> delivered
+	lost
+	// state: [final]
+	delivered
+`,
+			want: []string{"order: state lost is unreachable from the initial state created"},
+		},
+		{
+			name: "死胡同",
+			consts: `
+	// state: [initial] -> stuck
+	created order = iota
+	stuck
+`,
+			want: []string{"order: state stuck has no transitions and is not marked [final]"},
+		},
+		{
+			name: "终态声明转换",
+			consts: `
+	// state: [initial] -> delivered
+	created order = iota
+	// state: [final] -> created
+	delivered
+`,
+			want: []string{"order: final state delivered declares transitions"},
+		},
+		{
+			name: "无法结束的环",
+			consts: `
+	// state: [initial] -> ping, done
+	created order = iota
+	// state: -> pong
+	ping
+	// state: -> ping
+	pong
+	// state: [final]
+	done
+`,
+			want: []string{"order: states ping, pong form a cycle that can never reach a final state"},
+		},
+		{
+			name: "自环",
+			consts: `
+	// state: [initial] -> waiting, done
+	created order = iota
+	// state: -> waiting
+	waiting
+	// state: [final]
+	done
+`,
+			want: []string{"order: state waiting only transitions to itself and can never reach a final state"},
+		},
+		{
+			name: "无效状态不参与分析",
+			consts: `
+	// state: -> delivered
+	created order = iota
+	// state: [final]
+	delivered
+	// invalid
+	unknown
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enums := parseSource(t, stateSource(tt.consts))
+			diags := validateEnums(enums)
+			if got := messages(diags); !slices.Equal(got, tt.want) {
+				t.Errorf("diagnostics = %q, want %q", got, tt.want)
+			}
+			for _, d := range diags {
+				if d.Warning {
+					t.Errorf("%s: -statemachine/strict should report errors", d.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestReachability(t *testing.T) {
+	enum := &parseSource(t, stateSource(`
+	// state: [initial] -> paid, held
+	created order = iota
+	// state: -> delivered
+	paid
+	// state: -> delivered, created
+	held
+	// state: [final]
+	delivered
+	// state: -> delivered
+	orphan
+	// invalid
+	unknown
+`))[0]
+	got := reachability(enum)
+	want := []StateReach{
+		// 经由环可以回到自身
+		{State: "created", Reachable: []string{"created", "paid", "held", "delivered"}},
+		{State: "paid", Reachable: []string{"delivered"}},
+		{State: "held", Reachable: []string{"created", "paid", "held", "delivered"}},
+		{State: "delivered"},
+		{State: "orphan", Reachable: []string{"delivered"}},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("reachability returned %d states, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].State != want[i].State || !slices.Equal(got[i].Reachable, want[i].Reachable) {
+			t.Errorf("reachability[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestExpandTransitions(t *testing.T) {
+	tests := []struct {
+		name        string
+		consts      string
+		transitions map[string][]string
+		events      map[string][]string // 格式为 "event->target"
+	}{
+		{
+			name: "通配符目标",
+			consts: `
+	// state: [initial] -> *
+	created order = iota
+	// state: -> done
+	paid
+	// state: [final]
+	done
+	// invalid
+	unknown
+`,
+			// 不包含自身、终态和无效状态
+			transitions: map[string][]string{"created": {"paid"}, "paid": {"done"}, "done": nil, "unknown": nil},
+		},
+		{
+			name: "标签目标",
+			consts: `
+	// state: [initial] -> tag:closed
+	created order = iota
+	// tag: closed
+	// state: [final]
+	delivered
+	// tag: closed
+	// state: [final]
+	canceled
+`,
+			// 标签选择器包含终态
+			transitions: map[string][]string{"created": {"delivered", "canceled"}},
+		},
+		{
+			name: "通配符来源",
+			consts: `
+	// state: [initial] -> paid
+	created order = iota
+	// state: -> delivered
+	paid
+	// state: [final]
+	delivered
+	// state: [final] * -> canceled
+	canceled
+`,
+			// 规则只添加到非终态
+			transitions: map[string][]string{
+				"created":   {"paid", "canceled"},
+				"paid":      {"delivered", "canceled"},
+				"delivered": nil,
+				"canceled":  nil,
+			},
+		},
+		{
+			name: "标签来源",
+			consts: `
+	// tag: active
+	// state: [initial] -> paid
+	created order = iota
+	// state: -> delivered
+	paid
+	// state: [final]
+	delivered
+	// state: [final] tag:active -> canceled
+	canceled
+`,
+			transitions: map[string][]string{"created": {"paid", "canceled"}, "paid": {"delivered"}},
+		},
+		{
+			name: "事件保持不变",
+			consts: `
+	// state: [initial] pay -> paid; -> *
+	created order = iota
+	// state: ship -> delivered
+	paid
+	// state: -> delivered
+	held
+	// state: [final] * -> canceled
+	delivered
+	// state: [final]
+	canceled
+`,
+			// 通配符展开的转换没有事件，已有转换不重复添加
+			transitions: map[string][]string{
+				"created": {"paid", "held", "canceled"},
+				"paid":    {"delivered", "canceled"},
+			},
+			events: map[string][]string{
+				"created": {"pay->paid"},
+				"paid":    {"ship->delivered"},
+				"held":    nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkTransitions(t, &parseSource(t, stateSource(tt.consts))[0], tt.transitions, tt.events)
+		})
+	}
+}
+
+// checkTransitions 比较各状态展开后的转换和事件
+func checkTransitions(t *testing.T, enum *EnumInfo, transitions, events map[string][]string) {
+	t.Helper()
+	for name, want := range transitions {
+		v := findState(enum, name)
+		if v == nil {
+			t.Fatalf("state %s not found", name)
+		}
+		if !slices.Equal(v.Transitions, want) {
+			t.Errorf("%s: transitions = %v, want %v", name, v.Transitions, want)
+		}
+	}
+	for name, want := range events {
+		var got []string
+		for _, e := range findState(enum, name).Events {
+			got = append(got, e.Event+"->"+e.Target)
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("%s: events = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestSelectorsKept(t *testing.T) {
+	enum := &parseSource(t, stateSource(`
+	// state: [initial] -> *, tag:closed, done
+	created order = iota
+	// tag: closed
+	// state: [final]
+	done
+`))[0]
+	v := findState(enum, "created")
+	if want := []string{"*", "tag:closed"}; !slices.Equal(v.Selectors, want) {
+		t.Errorf("selectors = %v, want %v", v.Selectors, want)
+	}
+	if want := []string{"done"}; !slices.Equal(v.Transitions, want) {
+		t.Errorf("transitions = %v, want %v", v.Transitions, want)
+	}
+}
+
+func TestHierarchicalStates(t *testing.T) {
+	enum := &parseSource(t, stateSource(`
+	// state: [initial] -> paid
+	created order = iota
+	// state: cancel -> canceled; hold -> onHold
+	active
+	// state: parent=active ship -> shipped
+	paid
+	// state: parent=active cancel -> refunded
+	shipped
+	// state: parent=paid
+	packing
+	// state: parent=active resume -> paid
+	onHold
+	// state: parent=active [final]
+	archived
+	// state: [final]
+	canceled
+	// state: [final]
+	refunded
+`))[0]
+	if !enum.Hierarchical {
+		t.Error("Hierarchical should be set")
+	}
+	checkTransitions(t, enum,
+		map[string][]string{
+			"paid": {"shipped", "canceled", "onHold"},
+			// 子状态的 cancel 事件覆盖父状态的 cancel 事件
+			"shipped": {"refunded", "onHold"},
+			// 依次继承父状态和祖父状态
+			"packing": {"shipped", "canceled", "onHold"},
+			// 不继承指向自身的转换
+			"onHold": {"paid", "canceled"},
+			// 终态不继承
+			"archived": nil,
+		},
+		map[string][]string{
+			"paid":     {"ship->shipped", "cancel->canceled", "hold->onHold"},
+			"shipped":  {"cancel->refunded", "hold->onHold"},
+			"packing":  {"ship->shipped", "cancel->canceled", "hold->onHold"},
+			"onHold":   {"resume->paid", "cancel->canceled"},
+			"archived": nil,
+		})
+	if got := ancestors(enum, "packing"); !slices.Equal(got, []string{"paid", "active"}) {
+		t.Errorf("ancestors(packing) = %v", got)
+	}
+	if got := childStates(enum, "active"); !slices.Equal(got, []string{"paid", "shipped", "onHold", "archived"}) {
+		t.Errorf("childStates(active) = %v", got)
+	}
+}
+
+func TestCheckHierarchicalStates(t *testing.T) {
+	tests := []struct {
+		name   string
+		consts string
+		want   []string
+	}{
+		{
+			name: "分组父状态",
+			consts: `
+	// state: [initial] -> paid
+	created order = iota
+	active
+	// state: parent=active -> shipped
+	paid
+	// state: parent=active -> delivered
+	shipped
+	// state: [final]
+	delivered
+`,
+			// 父状态通过子状态进入和离开，既不是死胡同也不是不可达状态
+		},
+		{
+			name: "未知父状态",
+			consts: `
+	// state: [initial] -> paid
+	created order = iota
+	// state: -> delivered
+	active
+	// state: parent=activ -> delivered
+	paid
+	// state: [final]
+	delivered
+`,
+			want: []string{
+				`order: unknown parent "activ" in state of paid (did you mean "active"?)`,
+				"order: state active is unreachable from the initial state created",
+			},
+		},
+		{
+			name: "父状态成环",
+			consts: `
+	// state: [initial] -> a
+	created order = iota
+	// state: parent=b -> done
+	a
+	// state: parent=a -> done
+	b
+	// state: [final]
+	done
+`,
+			want: []string{
+				"order: state a is its own ancestor",
+				"order: state b is its own ancestor",
+			},
+		},
+		{
+			name: "无效父状态",
+			consts: `
+	// state: [initial] -> paid
+	created order = iota
+	// invalid
+	active
+	// state: parent=active -> delivered
+	paid
+	// state: [final]
+	delivered
+`,
+			want: []string{"order: parent active of paid is invalid"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enums := parseSource(t, stateSource(tt.consts))
+			if got := messages(validateEnums(enums)); !slices.Equal(got, tt.want) {
+				t.Errorf("diagnostics = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/donutnomad/goenum/enums"
+)
+
+// transitionTable builds the enums.TransitionTable of enum, the same data
+// the generated <Name>TransitionTable function returns. Invalid states are
+// omitted.
+func transitionTable(enum *EnumInfo) enums.TransitionTable {
+	table := enums.TransitionTable{Type: enum.Name, States: []enums.StateEntry{}}
+	for _, v := range enum.Values {
+		if v.IsInvalid {
+			continue
+		}
+		entry := enums.StateEntry{
+			Name:        v.Names[0],
+			Aliases:     v.Names[1:],
+			Value:       jsonConstValue(v.Value, enum.BaseType),
+			Tags:        v.Tags,
+			Initial:     v.IsInitial,
+			Final:       v.IsFinal,
+			Transitions: []string{},
+		}
+		for _, target := range validTargets(enum, v) {
+			entry.Transitions = append(entry.Transitions, stateName(enum, target))
+		}
+		for _, e := range v.Events {
+			entry.Events = append(entry.Events, enums.EventEntry{Event: e.Event, Target: stateName(enum, e.Target)})
+		}
+		if v.Parent != "" {
+			entry.Parent = stateName(enum, v.Parent)
+		}
+		if len(entry.Aliases) == 0 {
+			entry.Aliases = nil
+		}
+		table.States = append(table.States, entry)
+	}
+	return table
+}
+
+// validTargets returns the transitions of v that lead to valid states.
+func validTargets(enum *EnumInfo, v EnumValue) []string {
+	var targets []string
+	for _, target := range v.Transitions {
+		if t := findValue(enum.Values, target); t != nil && !t.IsInvalid {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+// stateName returns the first name of the value declared as constant name.
+func stateName(enum *EnumInfo, name string) string {
+	for _, v := range enum.Values {
+		if v.Name == name {
+			return v.Names[0]
+		}
+	}
+	return name
+}
+
+// jsonConstValue converts a constant value in Go syntax into a value that
+// marshals to the same JSON as the typed constant. Floats are converted to
+// their base type, so encoding/json formats them as it formats Val().
+func jsonConstValue(value string, baseType string) any {
+	if s, err := strconv.Unquote(value); err == nil {
+		return s
+	}
+	switch baseType {
+	case "float32":
+		if f, err := strconv.ParseFloat(value, 32); err == nil {
+			return float32(f)
+		}
+	case "float64":
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	}
+	if json.Valid([]byte(value)) {
+		return json.Number(value)
+	}
+	return value
+}
+
+// printTransitionTables writes the transition tables of every -statemachine
+// enum to w as a JSON array.
+func printTransitionTables(w io.Writer, enums []EnumInfo) error {
+	tables := []any{}
+	for i := range enums {
+		if enums[i].Options.StateMachine {
+			tables = append(tables, transitionTable(&enums[i]))
+		}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(tables)
+}
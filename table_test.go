@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestTransitionTableMatchesGenerated(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the generated code")
+	}
+	root, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"go.mod": "module tables\n\ngo 1.24\n\nrequire github.com/donutnomad/goenum v0.0.0\n\n" +
+			"replace github.com/donutnomad/goenum => " + root + "\n",
+		"order/order.go": `package order
+
+// goenums: -statemachine
+type status int
+
+const (
+	// invalid
+	unknown status = iota
+	// Pending, Open
+	// tag: active
+	// state: [initial] pay -> paid
+	pending
+	// state: [final]
+	paid status = 1 << 40
+)
+
+// goenums: -statemachine
+type weight float64
+
+const (
+	// state: [initial] -> heavy, huge
+	light weight = 0.5
+	// state: -> huge
+	heavy weight = 1000000
+	// state: [final]
+	huge weight = 1e21
+)
+
+// goenums: -statemachine
+type ratio float32
+
+const (
+	// state: [initial] -> full
+	tenth ratio = 0.1
+	// state: [final]
+	full ratio = 16777217
+)
+
+// goenums: -statemachine
+type color string
+
+const (
+	// state: [initial] -> blue
+	red color = "r<ed>"
+	// state: [final]
+	blue color = "b\"lue"
+)
+`,
+		"main.go": `package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"tables/order"
+)
+
+func main() {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	encoder.Encode([]any{
+		order.StatusTransitionTable(),
+		order.WeightTransitionTable(),
+		order.RatioTransitionTable(),
+		order.ColorTransitionTable(),
+	})
+}
+`,
+	})
+
+	pkg, err := loadPackage(filepath.Join(dir, "order"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	enums, err := parsePackage(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diags := validateEnums(enums); len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+	if err := generateEnumsFile(enums, filepath.Join(dir, "order", "order_enums.go")); err != nil {
+		t.Fatal(err)
+	}
+
+	// -table 的输出应与生成的 TransitionTable 序列化后完全相同
+	var want bytes.Buffer
+	if err := printTransitionTables(&want, enums); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("go", "run", ".")
+	cmd.Dir = dir
+	got, err := cmd.Output()
+	if err != nil {
+		var stderr []byte
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			stderr = exitErr.Stderr
+		}
+		t.Fatalf("go run failed: %v\n%s", err, stderr)
+	}
+	if !bytes.Equal(got, want.Bytes()) {
+		t.Errorf("generated tables:\n%s\n-table output:\n%s", got, want.Bytes())
+	}
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// supportedBaseTypes lists the underlying types an enum may be declared with.
+var supportedBaseTypes = map[string]bool{
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+	"float32": true, "float64": true,
+	"string": true,
+}
+
+// integerBaseTypes lists the base types usable for bit flags.
+var integerBaseTypes = map[string]bool{
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+}
+
+// Diagnostic is a problem found in an enum declaration. Warnings are
+// printed but do not abort generation.
+type Diagnostic struct {
+	Pos     token.Position
+	Message string
+	Warning bool
+}
+
+func (d Diagnostic) String() string {
+	if d.Warning {
+		return fmt.Sprintf("%s: warning: %s", d.Pos, d.Message)
+	}
+	return fmt.Sprintf("%s: %s", d.Pos, d.Message)
+}
+
+// validateEnums checks the parsed enums for annotations that would produce
+// wrong or uncompilable code.
+func validateEnums(enums []EnumInfo) []Diagnostic {
+	var diags []Diagnostic
+	for i := range enums {
+		diags = append(diags, validateEnum(&enums[i])...)
+	}
+	return diags
+}
+
+func validateEnum(enum *EnumInfo) []Diagnostic {
+	var diags []Diagnostic
+	report := func(pos token.Position, format string, args ...any) {
+		diags = append(diags, Diagnostic{Pos: pos, Message: fmt.Sprintf(format, args...)})
+	}
+
+	for _, flag := range enum.Options.UnknownFlags {
+		report(enum.Pos, "%s: unknown goenums flag %q", enum.Type, flag)
+	}
+
+	if !supportedBaseTypes[enum.BaseType] {
+		if enum.BaseType == "" {
+			report(enum.Pos, "%s: enum must be declared with a basic underlying type", enum.Type)
+		} else {
+			report(enum.Pos, "%s: unsupported base type %s", enum.Type, enum.BaseType)
+		}
+	}
+
+	if enum.Options.Flags && !integerBaseTypes[enum.BaseType] {
+		report(enum.Pos, "%s: -flags requires an integer base type, got %s", enum.Type, enum.BaseType)
+	}
+
+	if len(enum.Values) == 0 {
+		report(enum.Pos, "%s: no constants of this type found in package", enum.Type)
+		return diags
+	}
+
+	valueNames := make(map[string]bool)
+	for _, v := range enum.Values {
+		valueNames[v.Name] = true
+	}
+
+	seenNames := make(map[string]string)
+	seenKeys := make(map[string]string)
+	seenValues := make(map[string]string)
+	for _, v := range enum.Values {
+		for _, name := range v.Names {
+			if other, ok := seenNames[name]; ok {
+				report(v.NamesPos, "%s: name %q of %s is already used by %s", enum.Type, name, v.Name, other)
+				continue
+			}
+			seenNames[name] = v.Name
+			key := nameKey(enum.Options.NameMatch, name)
+			if other, ok := seenKeys[key]; ok && other != v.Name {
+				report(v.NamesPos, "%s: name %q of %s matches a name of %s with -serde/%s",
+					enum.Type, name, v.Name, other, enum.Options.NameMatch)
+				continue
+			}
+			seenKeys[key] = v.Name
+		}
+
+		if v.Unevaluated {
+			report(v.Pos, "%s: cannot evaluate the value of %s", enum.Type, v.Name)
+		} else if other, ok := seenValues[v.Value]; ok {
+			report(v.Pos, "%s: %s has the same value %s as %s", enum.Type, v.Name, v.Value, other)
+		} else {
+			seenValues[v.Value] = v.Name
+		}
+
+		if enum.Options.Flags && !v.IsInvalid && !v.Unevaluated && !isPowerOfTwo(v.Value) {
+			report(v.Pos, "%s: flag %s has value %s which is not a power of two", enum.Type, v.Name, v.Value)
+		}
+
+		for _, target := range v.Transitions {
+			if !valueNames[target] {
+				report(v.StatePos, "%s: unknown transition target %q in state of %s%s",
+					enum.Type, target, v.Name, suggest(target, enum.Values))
+			}
+		}
+
+		for _, selector := range v.Selectors {
+			if tag, ok := strings.CutPrefix(selector, "tag:"); ok && !slices.Contains(enum.AllTags, strings.TrimSpace(tag)) {
+				report(v.StatePos, "%s: unknown tag %q in state of %s", enum.Type, tag, v.Name)
+			}
+		}
+		for _, rule := range v.Rules {
+			if tag, ok := strings.CutPrefix(rule.Source, "tag:"); ok && !slices.Contains(enum.AllTags, strings.TrimSpace(tag)) {
+				report(v.StatePos, "%s: unknown tag %q in state of %s", enum.Type, tag, v.Name)
+			}
+			if !valueNames[rule.Target] {
+				report(v.StatePos, "%s: unknown transition target %q in state of %s%s",
+					enum.Type, rule.Target, v.Name, suggest(rule.Target, enum.Values))
+			}
+		}
+
+		if v.Parent != "" {
+			parent := findState(enum, v.Parent)
+			switch {
+			case parent == nil:
+				report(v.StatePos, "%s: unknown parent %q in state of %s%s",
+					enum.Type, v.Parent, v.Name, suggest(v.Parent, enum.Values))
+			case parent.IsInvalid:
+				report(v.StatePos, "%s: parent %s of %s is invalid", enum.Type, v.Parent, v.Name)
+			case slices.Contains(ancestors(enum, v.Parent), v.Name) || v.Parent == v.Name:
+				report(v.StatePos, "%s: state %s is its own ancestor", enum.Type, v.Name)
+			}
+		}
+
+		fired := make(map[string]string)
+		for _, e := range v.Events {
+			if isStateSelector(e.Target) {
+				report(v.StatePos, "%s: event %s of %s cannot target %s", enum.Type, e.Event, v.Name, e.Target)
+				continue
+			}
+			if !token.IsIdentifier(e.Event) {
+				report(v.StatePos, "%s: event %q in state of %s is not a valid identifier", enum.Type, e.Event, v.Name)
+			}
+			if other, ok := fired[e.Event]; ok && other != e.Target {
+				report(v.StatePos, "%s: event %s of %s leads to both %s and %s", enum.Type, e.Event, v.Name, other, e.Target)
+				continue
+			}
+			fired[e.Event] = e.Target
+		}
+
+		if v.IsFallback && v.Name != enum.Fallback {
+			report(v.Pos, "%s: %s is marked as fallback, but the fallback is already %s", enum.Type, v.Name, enum.Fallback)
+		}
+	}
+
+	seenEvents := make(map[string]string)
+	for _, event := range enum.Events {
+		constant := enum.Name + "Event" + FirstUpper(event)
+		if other, ok := seenEvents[constant]; ok {
+			report(enum.Pos, "%s: events %s and %s both generate the constant %s", enum.Type, other, event, constant)
+			continue
+		}
+		seenEvents[constant] = event
+	}
+
+	if name := enum.Options.Fallback; name != "" {
+		if value := findValue(enum.Values, name); value == nil {
+			report(enum.Pos, "%s: unknown -fallback value %q%s", enum.Type, name, suggest(name, enum.Values))
+		} else if value.Name != enum.Fallback {
+			report(enum.Pos, "%s: -fallback=%s conflicts with the fallback comment of %s", enum.Type, name, enum.Fallback)
+		}
+	}
+	if enum.Options.FallbackRaw {
+		if enum.Fallback == "" && enum.Options.Fallback == "" {
+			report(enum.Pos, "%s: -fallback/raw requires a fallback value", enum.Type)
+		}
+		if enum.Options.SerdeFormat == "name" {
+			report(enum.Pos, "%s: -fallback/raw cannot be used with -serde/name", enum.Type)
+		}
+	}
+	if enum.Fallback != "" && enum.Options.Flags {
+		report(enum.Pos, "%s: -flags enums cannot have a fallback value", enum.Type)
+	}
+
+	if enum.Options.Proto {
+		diags = append(diags, checkProto(enum)...)
+	}
+
+	if enum.Options.Check != "" {
+		for _, d := range checkStateMachine(enum) {
+			d.Warning = enum.Options.Check != "strict"
+			diags = append(diags, d)
+		}
+	}
+
+	return diags
+}
+
+// isPowerOfTwo reports whether the constant value is a positive power of two.
+func isPowerOfTwo(value string) bool {
+	n, err := strconv.ParseUint(value, 0, 64)
+	return err == nil && n != 0 && n&(n-1) == 0
+}
+
+// suggest returns a hint naming the value closest to an unknown target.
+func suggest(target string, values []EnumValue) string {
+	best, bestDist := "", len(target)/2+1
+	for _, v := range values {
+		if d := editDistance(strings.ToLower(target), strings.ToLower(v.Name)); d < bestDist {
+			best, bestDist = v.Name, d
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (did you mean %q?)", best)
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestValidateEnums(t *testing.T) {
+	tests := []struct {
+		name    string
+		options string
+		base    string
+		consts  string
+		want    []string // 格式为 "文件:行:列: 信息"
+	}{
+		{
+			name:    "正常",
+			options: "-json",
+			base:    "int",
+			consts: `
+	a x = iota
+	b
+`,
+		},
+		{
+			name:    "未知标志",
+			options: "-json -jsn",
+			base:    "int",
+			consts: `
+	a x = iota
+`,
+			want: []string{`enums.go:3:1: x: unknown goenums flag "-jsn"`},
+		},
+		{
+			name:    "不支持的基础类型",
+			options: "-json",
+			base:    "bool",
+			consts: `
+	a x = true
+`,
+			want: []string{"enums.go:3:1: x: unsupported base type bool"},
+		},
+		{
+			name:    "复合类型",
+			options: "-json",
+			base:    "[]int",
+			want: []string{
+				"enums.go:3:1: x: enum must be declared with a basic underlying type",
+				"enums.go:3:1: x: no constants of this type found in package",
+			},
+		},
+		{
+			name:    "空枚举",
+			options: "-json",
+			base:    "int",
+			want:    []string{"enums.go:3:1: x: no constants of this type found in package"},
+		},
+		{
+			name:    "重复名称",
+			options: "-json",
+			base:    "int",
+			consts: `
+	// Active
+	a x = iota
+	// Active, Enabled
+	b
+`,
+			want: []string{`enums.go:9:2: x: name "Active" of b is already used by a`},
+		},
+		{
+			name:    "重复值",
+			options: "-json",
+			base:    "string",
+			consts: `
+	a x = "v"
+	b x = "v"
+`,
+			want: []string{`enums.go:8:2: x: b has the same value "v" as a`},
+		},
+		{
+			name:    "未知转换目标",
+			options: "-statemachine",
+			base:    "int",
+			consts: `
+	// state: -> shiped, zzz
+	created x = iota
+	shipped
+`,
+			// 只为相近的名称给出建议
+			want: []string{
+				`enums.go:7:2: x: unknown transition target "shiped" in state of created (did you mean "shipped"?)`,
+				`enums.go:7:2: x: unknown transition target "zzz" in state of created`,
+			},
+		},
+		{
+			name:    "检查模式为警告",
+			options: "-statemachine/check",
+			base:    "int",
+			consts: `
+	// state: [initial] -> b
+	a x = iota
+	b
+`,
+			want: []string{"enums.go:9:2: warning: x: state b has no transitions and is not marked [final]"},
+		},
+		{
+			name:    "严格模式为错误",
+			options: "-statemachine/strict",
+			base:    "int",
+			consts: `
+	// state: [initial] -> b
+	a x = iota
+	b
+`,
+			want: []string{"enums.go:9:2: x: state b has no transitions and is not marked [final]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := "package order\n\n// goenums: " + tt.options + "\ntype x " + tt.base + "\n"
+			if tt.consts != "" {
+				src += "\nconst (" + tt.consts + ")\n"
+			}
+			var got []string
+			for _, d := range validateEnums(parseSource(t, src)) {
+				d.Pos.Filename = filepath.Base(d.Pos.Filename)
+				got = append(got, d.String())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("diagnostics = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"shipped", "shiped", 1},
+		{"kitten", "sitting", 3},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}